@@ -2,20 +2,49 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/aburizalpurnama/travel/internal/app/contract"
 	"github.com/aburizalpurnama/travel/internal/app/database"
+	"github.com/aburizalpurnama/travel/internal/app/domain/admin"
+	"github.com/aburizalpurnama/travel/internal/app/domain/agent"
+	"github.com/aburizalpurnama/travel/internal/app/domain/auth"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/domain/departure"
+	"github.com/aburizalpurnama/travel/internal/app/domain/financing"
+	"github.com/aburizalpurnama/travel/internal/app/domain/installment"
+	"github.com/aburizalpurnama/travel/internal/app/domain/invoice"
+	"github.com/aburizalpurnama/travel/internal/app/domain/muthawif"
+	"github.com/aburizalpurnama/travel/internal/app/domain/passenger"
+	"github.com/aburizalpurnama/travel/internal/app/domain/payment"
 	"github.com/aburizalpurnama/travel/internal/app/domain/product"
+	"github.com/aburizalpurnama/travel/internal/app/domain/referral"
+	"github.com/aburizalpurnama/travel/internal/app/domain/refund"
+	"github.com/aburizalpurnama/travel/internal/app/domain/reschedule"
+	"github.com/aburizalpurnama/travel/internal/app/domain/user"
+	"github.com/aburizalpurnama/travel/internal/app/domain/voucher"
+	"github.com/aburizalpurnama/travel/internal/app/domain/wallet"
 	"github.com/aburizalpurnama/travel/internal/app/repository"
 	"github.com/aburizalpurnama/travel/internal/app/router"
 	"github.com/aburizalpurnama/travel/internal/config"
+	"github.com/aburizalpurnama/travel/internal/pkg/bookingcode"
+	"github.com/aburizalpurnama/travel/internal/pkg/mail"
 	"github.com/aburizalpurnama/travel/internal/pkg/mapper"
+	"github.com/aburizalpurnama/travel/internal/pkg/password"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/aburizalpurnama/travel/internal/pkg/referralcode"
 	"github.com/aburizalpurnama/travel/internal/pkg/telemetry"
+	"github.com/aburizalpurnama/travel/internal/pkg/token"
 	"github.com/gofiber/fiber/v2"
 	fiberLogger "github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -34,6 +63,22 @@ func main() {
 
 	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: logLevel}))
 
+	// Access tokens cannot be signed safely without a secret
+	if cfg.JwtSecret == "" {
+		log.Fatal("Error loading config: JWT_SECRET is required")
+	}
+
+	// A malformed policy would silently deny or grant permissions, so it fails startup instead
+	policy, err := rbac.ParsePolicy(cfg.RBACPolicy)
+	if err != nil {
+		log.Fatalf("Error loading config: RBAC_POLICY: %v", err)
+	}
+
+	mailer, err := newMailSender(cfg, logger)
+	if err != nil {
+		log.Fatalf("Error loading config: %v", err)
+	}
+
 	// Initialize OpenTelemetry tracer provider
 	shutdownTracer, err := telemetry.InitTracerProvider(telemetry.Option{
 		Enabled:      cfg.Tracing.Enabled,
@@ -62,10 +107,40 @@ func main() {
 		log.Fatalf("Could not connect to the database: %v", err)
 	}
 
+	// Create the first super admin, who manages every other admin account
+	if cfg.BootstrapSuperAdmin.Email != "" {
+		created, err := admin.EnsureSuperAdmin(context.Background(), repository.NewGORMUnitOfWork(db),
+			password.NewBcryptHasher(cfg.PasswordHashCost), admin.BootstrapOption{
+				FullName: cfg.BootstrapSuperAdmin.FullName,
+				Email:    cfg.BootstrapSuperAdmin.Email,
+				Password: cfg.BootstrapSuperAdmin.Password,
+			})
+		if err != nil {
+			log.Fatalf("Could not create the initial super admin: %v", err)
+		}
+		if created {
+			logger.Info("created the initial super admin", "email", cfg.BootstrapSuperAdmin.Email)
+		}
+	}
+
 	// Inject dependencies and configure router options
-	routerOpts := injectDependencies(db)
+	routerOpts := injectDependencies(db, cfg, policy, mailer)
 	routerOpts.Logger = logger
 
+	// Cancel the application context when the process receives a termination signal
+	appCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Start background workers
+
+	if cfg.BookingExpiry.Enabled {
+		expiryWorker := booking.NewExpiryWorker(repository.NewGORMUnitOfWork(db), booking.ExpiryOption{
+			Interval:  cfg.BookingExpiry.Interval,
+			BatchSize: cfg.BookingExpiry.BatchSize,
+		}, logger)
+		go expiryWorker.Run(appCtx)
+	}
+
 	// Initialize Fiber app
 	app := fiber.New()
 	app.Use(fiberLogger.New())
@@ -73,21 +148,157 @@ func main() {
 	// Setup API routes
 	router.SetupRoutesV1(app, routerOpts)
 
+	// Shut the server down gracefully once the application context is canceled
+	go func() {
+		<-appCtx.Done()
+		if err := app.Shutdown(); err != nil {
+			log.Printf("Error shutting down server: %v", err)
+		}
+	}()
+
 	// Start the server
 	port := fmt.Sprintf(":%d", cfg.ServerPort)
-	log.Fatal(app.Listen(port))
+	if err := app.Listen(port); err != nil {
+		log.Fatal(err)
+	}
 }
 
 // injectDependencies wires up the application dependencies (repositories, services, handlers).
-func injectDependencies(db *gorm.DB) *router.Option {
+func injectDependencies(db *gorm.DB, cfg *config.Config, policy *rbac.Policy, mailer contract.MailSender) *router.Option {
 	uow := repository.NewGORMUnitOfWork(db)
 	mapper := mapper.NewCopierMapper()
 
 	productService := product.NewService(uow, mapper)
 	productHandler := product.NewHandler(productService)
 
+	bookingCodeGenerator := bookingcode.NewCrockfordGenerator(cfg.BookingCodePrefix, 6)
+	bookingService := booking.NewService(uow, mapper, bookingCodeGenerator, booking.Option{
+		PaymentTimeout: cfg.BookingPaymentTimeout,
+	})
+	bookingHandler := booking.NewHandler(bookingService)
+
+	downPaymentRule := payment.DownPaymentRule{
+		MinPercent: decimal.NewFromFloat(cfg.BookingDownPaymentPercent),
+		MinAmount:  decimal.NewFromFloat(cfg.BookingDownPaymentMinAmount),
+	}
+	referralRule := referral.RewardRule{
+		Amount: decimal.NewFromFloat(cfg.ReferralRewardAmount),
+	}
+	paymentService := payment.NewService(uow, mapper, downPaymentRule, referralRule)
+	paymentHandler := payment.NewHandler(paymentService)
+
+	installmentService := installment.NewService(uow, mapper, installment.Option{PaymentTimeout: cfg.BookingPaymentTimeout})
+	installmentHandler := installment.NewHandler(installmentService)
+
+	refundService := refund.NewService(uow, mapper)
+	refundHandler := refund.NewHandler(refundService)
+
+	rescheduleService := reschedule.NewService(uow, mapper, downPaymentRule)
+	rescheduleHandler := reschedule.NewHandler(rescheduleService)
+
+	departureService := departure.NewService(uow, mapper)
+	departureHandler := departure.NewHandler(departureService)
+
+	voucherService := voucher.NewService(uow, mapper)
+	voucherHandler := voucher.NewHandler(voucherService)
+
+	passengerService := passenger.NewService(uow, mapper)
+	passengerHandler := passenger.NewHandler(passengerService)
+
+	invoiceService := invoice.NewService(uow, mapper, invoice.Option{
+		NumberPrefix:  cfg.InvoiceNumberPrefix,
+		TaxPercent:    decimal.NewFromFloat(cfg.InvoiceTaxPercent),
+		IssuerName:    cfg.InvoiceIssuerName,
+		IssuerAddress: cfg.InvoiceIssuerAddress,
+	})
+	invoiceHandler := invoice.NewHandler(invoiceService)
+
+	walletService := wallet.NewService(uow, mapper)
+	walletHandler := wallet.NewHandler(walletService)
+
+	referralCodeGenerator := referralcode.NewCrockfordGenerator(cfg.ReferralCodeLength)
+	referralService := referral.NewService(uow, mapper, referralCodeGenerator)
+	referralHandler := referral.NewHandler(referralService)
+
+	muthawifService := muthawif.NewService(uow, mapper)
+	muthawifHandler := muthawif.NewHandler(muthawifService)
+
+	financingService := financing.NewService(uow, mapper, downPaymentRule, referralRule, cfg.BookingPaymentTimeout)
+	financingHandler := financing.NewHandler(financingService)
+
+	passwordHasher := password.NewBcryptHasher(cfg.PasswordHashCost)
+	accessTokenTTL := time.Duration(cfg.JwtExpirationMinutes) * time.Minute
+	accessTokenManager := token.NewJWTManager(cfg.JwtSecret, accessTokenTTL)
+	authService := auth.NewService(uow, passwordHasher, accessTokenManager, auth.Option{
+		AccessTokenTTL:  accessTokenTTL,
+		RefreshTokenTTL: cfg.RefreshTokenTTL,
+	})
+	authHandler := auth.NewHandler(authService)
+
+	signedTokenManager := token.NewSignedTokenManager(cfg.JwtSecret)
+	userService := user.NewService(uow, mapper, passwordHasher, referral.Apply, authService.EndPrincipalSessions, mailer, signedTokenManager, user.Option{
+		Verification: user.VerificationOption{
+			URL:             cfg.EmailVerification.URL,
+			TTL:             cfg.EmailVerification.TTL,
+			ResendCooldown:  cfg.EmailVerification.ResendCooldown,
+			MaxSendsPerHour: cfg.EmailVerification.MaxSendsPerHour,
+		},
+		PasswordReset: user.PasswordResetOption{
+			URL:            cfg.PasswordReset.URL,
+			TTL:            cfg.PasswordReset.TTL,
+			ResendCooldown: cfg.PasswordReset.ResendCooldown,
+		},
+	})
+	userHandler := user.NewHandler(userService)
+
+	adminService := admin.NewService(uow, mapper, passwordHasher, authService.EndPrincipalSessions)
+	adminHandler := admin.NewHandler(adminService)
+
+	agentService := agent.NewService(uow, mapper, authService.EndPrincipalSessions)
+	agentHandler := agent.NewHandler(agentService)
+
 	return &router.Option{
-		ProductHandler: productHandler,
+		AccessTokenManager:  accessTokenManager,
+		AccessTokenDenylist: uow.AccessTokenDenylistRepository(),
+		Policy:              policy,
+		AuthHandler:         authHandler,
+		ProductHandler:      productHandler,
+		BookingHandler:      bookingHandler,
+		PaymentHandler:      paymentHandler,
+		InstallmentHandler:  installmentHandler,
+		RefundHandler:       refundHandler,
+		RescheduleHandler:   rescheduleHandler,
+		DepartureHandler:    departureHandler,
+		VoucherHandler:      voucherHandler,
+		PassengerHandler:    passengerHandler,
+		InvoiceHandler:      invoiceHandler,
+		WalletHandler:       walletHandler,
+		ReferralHandler:     referralHandler,
+		MuthawifHandler:     muthawifHandler,
+		AgentHandler:        agentHandler,
+		FinancingHandler:    financingHandler,
+		UserHandler:         userHandler,
+		AdminHandler:        adminHandler,
+	}
+}
+
+// newMailSender returns the mail sender selected by the MAIL_DRIVER setting.
+func newMailSender(cfg *config.Config, logger *slog.Logger) (contract.MailSender, error) {
+	switch cfg.MailDriver {
+	case "log":
+		return mail.NewLogSender(logger, cfg.MailLogDir), nil
+	case "mailgun":
+		if cfg.MailgunApiKey == "" || cfg.MailgunDomain == "" || cfg.MailSenderEmail == "" {
+			return nil, errors.New("MAILGUN_API_KEY, MAILGUN_DOMAIN and MAILGUN_SENDER_EMAIL are required by the mailgun driver")
+		}
+		return mail.NewMailgunSender(mail.MailgunOption{
+			APIKey:  cfg.MailgunApiKey,
+			Domain:  cfg.MailgunDomain,
+			From:    cfg.MailSenderEmail,
+			BaseURL: cfg.MailgunBaseURL,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown MAIL_DRIVER %q, expected \"log\" or \"mailgun\"", cfg.MailDriver)
 	}
 }
 
@@ -0,0 +1,44 @@
+// Package apptest provides the fakes and assertions shared by the tests of the domain packages.
+package apptest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// AssertError compares the code and message of an expected error, ignoring its details.
+// A nil want expects no error.
+func AssertError(t testing.TB, err error, want *apperror.AppError) {
+	t.Helper()
+
+	if want == nil {
+		if err != nil {
+			t.Fatalf("error = %v, want nil", err)
+		}
+		return
+	}
+
+	var appErr *apperror.AppError
+	if !errors.As(err, &appErr) || appErr.Code != want.Code || appErr.Message != want.Message {
+		t.Fatalf("error = %v, want %q (%s)", err, want.Message, want.Code)
+	}
+}
+
+// AssertCode compares the code of an expected error. An empty code expects no error.
+func AssertCode(t testing.TB, err error, want apperror.Code) {
+	t.Helper()
+
+	if want == "" {
+		if err != nil {
+			t.Fatalf("error = %v, want nil", err)
+		}
+		return
+	}
+
+	var appErr *apperror.AppError
+	if !errors.As(err, &appErr) || appErr.Code != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
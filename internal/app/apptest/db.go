@@ -0,0 +1,39 @@
+package apptest
+
+import (
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// DryRunDB opens a database that builds statements without executing them.
+func DryRunDB(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost dbname=test"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	return db
+}
+
+// CaptureUpdates records the UPDATE statements built on db with their values inlined.
+func CaptureUpdates(t testing.TB, db *gorm.DB) *[]string {
+	t.Helper()
+
+	var statements []string
+	err := db.Callback().Update().After("gorm:update").Register("apptest:capture", func(tx *gorm.DB) {
+		statements = append(statements, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	return &statements
+}
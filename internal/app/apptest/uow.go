@@ -0,0 +1,58 @@
+package apptest
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+)
+
+// UnitOfWork is a fake unit of work that runs transactions in place and hands out the repositories
+// a test sets. Repositories left unset are nil, as is every method not listed here.
+type UnitOfWork struct {
+	contract.UnitOfWork
+	Users                  contract.UserRepository
+	RefreshTokens          contract.RefreshTokenRepository
+	AccessTokenDenylist    contract.AccessTokenDenylistRepository
+	EmailVerificationSends contract.EmailVerificationSendRepository
+	Bookings               contract.BookingRepository
+	BookingStatusHistories contract.BookingStatusHistoryRepository
+	DepartureBatches       contract.DepartureBatchRepository
+	Vouchers               contract.VoucherRepository
+	VoucherRedemptions     contract.VoucherRedemptionRepository
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.UnitOfWork = (*UnitOfWork)(nil)
+
+// RunInTransaction runs fn with the unit of work itself.
+func (u *UnitOfWork) RunInTransaction(ctx context.Context, fn func(ctx context.Context, uow contract.UnitOfWork) error) error {
+	return fn(ctx, u)
+}
+
+func (u *UnitOfWork) UserRepository() contract.UserRepository { return u.Users }
+
+func (u *UnitOfWork) RefreshTokenRepository() contract.RefreshTokenRepository { return u.RefreshTokens }
+
+func (u *UnitOfWork) AccessTokenDenylistRepository() contract.AccessTokenDenylistRepository {
+	return u.AccessTokenDenylist
+}
+
+func (u *UnitOfWork) EmailVerificationSendRepository() contract.EmailVerificationSendRepository {
+	return u.EmailVerificationSends
+}
+
+func (u *UnitOfWork) BookingRepository() contract.BookingRepository { return u.Bookings }
+
+func (u *UnitOfWork) BookingStatusHistoryRepository() contract.BookingStatusHistoryRepository {
+	return u.BookingStatusHistories
+}
+
+func (u *UnitOfWork) DepartureBatchRepository() contract.DepartureBatchRepository {
+	return u.DepartureBatches
+}
+
+func (u *UnitOfWork) VoucherRepository() contract.VoucherRepository { return u.Vouchers }
+
+func (u *UnitOfWork) VoucherRedemptionRepository() contract.VoucherRedemptionRepository {
+	return u.VoucherRedemptions
+}
@@ -0,0 +1,26 @@
+package contract
+
+import "time"
+
+// BookingCodeGenerator defines the contract for generating human-readable booking codes.
+type BookingCodeGenerator interface {
+	// Generate returns a new booking code for a booking created at the given time.
+	// Codes are not guaranteed to be unique; callers must handle collisions.
+	Generate(now time.Time) (string, error)
+}
+
+// ReferralCodeGenerator defines the contract for generating the referral codes users share with others.
+type ReferralCodeGenerator interface {
+	// Generate returns a new referral code.
+	// Codes are not guaranteed to be unique; callers must handle collisions.
+	Generate() (string, error)
+}
+
+// PasswordHasher defines the contract for hashing and verifying user passwords.
+type PasswordHasher interface {
+	// Hash returns a salted, one-way hash of the password that is safe to store.
+	Hash(password string) (string, error)
+
+	// Verify reports whether the password matches the stored hash.
+	Verify(hash, password string) (bool, error)
+}
@@ -0,0 +1,17 @@
+package contract
+
+import "context"
+
+// MailMessage is an email to a single recipient, with a plain text body and an optional HTML one.
+type MailMessage struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// MailSender defines the contract for delivering emails.
+type MailSender interface {
+	// Send delivers the message, returning an error when it was not accepted for delivery.
+	Send(ctx context.Context, msg MailMessage) error
+}
@@ -2,8 +2,11 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
 )
 
 // ProductRepository defines the standard database operations for the Product model.
@@ -26,3 +29,531 @@ type ProductRepository interface {
 	// Delete removes a product record from the database by its ID.
 	Delete(ctx context.Context, id uint) error
 }
+
+// UserRepository defines the standard database operations for the User model.
+type UserRepository interface {
+	// FindAll retrieves a list of users based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.UserFilter) ([]model.User, error)
+
+	// Count returns the total number of users that match the given filter.
+	Count(ctx context.Context, filter *model.UserFilter) (int64, error)
+
+	// FindByID retrieves a single user by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.User, error)
+
+	// FindByIDForUpdate retrieves a single user by its ID and locks the row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*model.User, error)
+
+	// FindByReferralCode retrieves the active user owning the given referral code.
+	FindByReferralCode(ctx context.Context, code string) (*model.User, error)
+
+	// FindByLogin retrieves the active user whose email or phone number matches the given login identifier.
+	FindByLogin(ctx context.Context, login string) (*model.User, error)
+
+	// FindByEmail retrieves the active user with the given email address, compared case-insensitively.
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
+
+	// Save persists a new user record to the database.
+	Save(ctx context.Context, user *model.User) (*model.User, error)
+
+	// Update modifies an existing user record in the database.
+	Update(ctx context.Context, user *model.User) (*model.User, error)
+
+	// Delete removes a user record from the database by its ID.
+	Delete(ctx context.Context, id uint) error
+}
+
+// BookingRepository defines the standard database operations for the Booking model.
+type BookingRepository interface {
+	// FindAll retrieves a list of bookings based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.BookingFilter) ([]model.Booking, error)
+
+	// Count returns the total number of bookings that match the given filter.
+	Count(ctx context.Context, filter *model.BookingFilter) (int64, error)
+
+	// FindByID retrieves a single booking by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.Booking, error)
+
+	// FindByIDForUpdate retrieves a single booking by its ID and locks the row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*model.Booking, error)
+
+	// FindByCode retrieves a single booking by its human-readable booking code.
+	FindByCode(ctx context.Context, code string) (*model.Booking, error)
+
+	// FindExpiredForUpdate retrieves and locks up to 'limit' bookings without any payment whose payment deadline is before 'now'.
+	// Rows already locked by another transaction are skipped.
+	FindExpiredForUpdate(ctx context.Context, now time.Time, limit int) ([]model.Booking, error)
+
+	// FindActiveByDepartureBatchIDs retrieves the bookings on the given departure batches that are neither canceled nor refunded.
+	FindActiveByDepartureBatchIDs(ctx context.Context, batchIDs []uint) ([]model.Booking, error)
+
+	// Save persists a new booking record to the database.
+	Save(ctx context.Context, booking *model.Booking) (*model.Booking, error)
+
+	// Update modifies an existing booking record in the database.
+	Update(ctx context.Context, booking *model.Booking) (*model.Booking, error)
+
+	// Delete removes a booking record from the database by its ID.
+	Delete(ctx context.Context, id uint) error
+}
+
+// BookingStatusHistoryRepository defines the database operations for the BookingStatusHistory model.
+type BookingStatusHistoryRepository interface {
+	// FindByBookingID retrieves the status history of a booking in chronological order.
+	FindByBookingID(ctx context.Context, bookingID uint) ([]model.BookingStatusHistory, error)
+
+	// Save persists a new status history record to the database.
+	Save(ctx context.Context, history *model.BookingStatusHistory) (*model.BookingStatusHistory, error)
+}
+
+// PaymentRepository defines the database operations for the Payment model.
+type PaymentRepository interface {
+	// FindAll retrieves a list of payments based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.PaymentFilter) ([]model.Payment, error)
+
+	// Count returns the total number of payments that match the given filter.
+	Count(ctx context.Context, filter *model.PaymentFilter) (int64, error)
+
+	// FindByID retrieves a single payment by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.Payment, error)
+
+	// Save persists a new payment record to the database.
+	Save(ctx context.Context, payment *model.Payment) (*model.Payment, error)
+
+	// SumAmountByBookingID returns the total amount of all payments recorded for a booking.
+	SumAmountByBookingID(ctx context.Context, bookingID uint) (decimal.Decimal, error)
+}
+
+// InstallmentPlanRepository defines the database operations for the InstallmentPlan model.
+type InstallmentPlanRepository interface {
+	// FindAll retrieves a list of installment plans based on pagination and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.InstallmentPlanFilter) ([]model.InstallmentPlan, error)
+
+	// Count returns the total number of installment plans matching the filter criteria.
+	Count(ctx context.Context, filter *model.InstallmentPlanFilter) (int64, error)
+
+	// FindByID retrieves a single installment plan by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.InstallmentPlan, error)
+
+	// FindByIDForUpdate retrieves a single installment plan by its ID and locks the row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*model.InstallmentPlan, error)
+
+	// FindByBookingID retrieves the active installment plan of a booking, including its dues.
+	FindByBookingID(ctx context.Context, bookingID uint) (*model.InstallmentPlan, error)
+
+	// Save persists a new installment plan record to the database.
+	Save(ctx context.Context, plan *model.InstallmentPlan) (*model.InstallmentPlan, error)
+
+	// Update modifies an existing installment plan record in the database.
+	Update(ctx context.Context, plan *model.InstallmentPlan) (*model.InstallmentPlan, error)
+}
+
+// InstallmentDueRepository defines the database operations for the InstallmentDue model.
+type InstallmentDueRepository interface {
+	// FindOutstandingByBookingIDForUpdate retrieves and locks the unsettled dues of a booking, oldest first.
+	FindOutstandingByBookingIDForUpdate(ctx context.Context, bookingID uint) ([]model.InstallmentDue, error)
+
+	// FindOverdue retrieves unsettled dues whose due date is before the given date, oldest first.
+	FindOverdue(ctx context.Context, page *int, size *int, before time.Time) ([]model.InstallmentDue, error)
+
+	// CountOverdue returns the number of unsettled dues whose due date is before the given date.
+	CountOverdue(ctx context.Context, before time.Time) (int64, error)
+
+	// SaveAll persists a batch of new installment due records to the database.
+	SaveAll(ctx context.Context, dues []model.InstallmentDue) error
+
+	// Update modifies an existing installment due record in the database.
+	Update(ctx context.Context, due *model.InstallmentDue) (*model.InstallmentDue, error)
+}
+
+// RefundRepository defines the database operations for the Refund model.
+type RefundRepository interface {
+	// FindAll retrieves a list of refunds based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.RefundFilter) ([]model.Refund, error)
+
+	// Count returns the total number of refunds that match the given filter.
+	Count(ctx context.Context, filter *model.RefundFilter) (int64, error)
+
+	// FindByID retrieves a single refund by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.Refund, error)
+
+	// FindByIDForUpdate retrieves a single refund by its ID and locks the row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*model.Refund, error)
+
+	// FindOpenByBookingID retrieves the refund of a booking that has not been rejected, if any.
+	FindOpenByBookingID(ctx context.Context, bookingID uint) (*model.Refund, error)
+
+	// Save persists a new refund record to the database.
+	Save(ctx context.Context, refund *model.Refund) (*model.Refund, error)
+
+	// Update modifies an existing refund record in the database.
+	Update(ctx context.Context, refund *model.Refund) (*model.Refund, error)
+}
+
+// RescheduleRepository defines the database operations for the Reschedule model.
+type RescheduleRepository interface {
+	// FindAll retrieves a list of reschedules based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.RescheduleFilter) ([]model.Reschedule, error)
+
+	// Count returns the total number of reschedules that match the given filter.
+	Count(ctx context.Context, filter *model.RescheduleFilter) (int64, error)
+
+	// FindByID retrieves a single reschedule by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.Reschedule, error)
+
+	// FindByIDForUpdate retrieves a single reschedule by its ID and locks the row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*model.Reschedule, error)
+
+	// FindOpenByBookingID retrieves the reschedule of a booking that is still requested or approved, if any.
+	FindOpenByBookingID(ctx context.Context, bookingID uint) (*model.Reschedule, error)
+
+	// Save persists a new reschedule record to the database.
+	Save(ctx context.Context, reschedule *model.Reschedule) (*model.Reschedule, error)
+
+	// Update modifies an existing reschedule record in the database.
+	Update(ctx context.Context, reschedule *model.Reschedule) (*model.Reschedule, error)
+}
+
+// DepartureBatchRepository defines the database operations for the DepartureBatch model.
+type DepartureBatchRepository interface {
+	// FindAll retrieves a list of departure batches based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.DepartureBatchFilter) ([]model.DepartureBatch, error)
+
+	// Count returns the total number of departure batches that match the given filter.
+	Count(ctx context.Context, filter *model.DepartureBatchFilter) (int64, error)
+
+	// FindByID retrieves a single departure batch by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.DepartureBatch, error)
+
+	// FindByIDForUpdate retrieves a single departure batch by its ID and locks the row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*model.DepartureBatch, error)
+
+	// AdjustSeatsTaken atomically adds delta (which may be negative) to the seats taken of a batch.
+	// It reports false without changing anything when the result would fall outside zero and the quota.
+	AdjustSeatsTaken(ctx context.Context, id uint, delta int) (bool, error)
+
+	// Save persists a new departure batch record to the database.
+	Save(ctx context.Context, batch *model.DepartureBatch) (*model.DepartureBatch, error)
+
+	// Update modifies an existing departure batch record in the database.
+	Update(ctx context.Context, batch *model.DepartureBatch) (*model.DepartureBatch, error)
+
+	// Delete removes a departure batch record from the database by its ID.
+	Delete(ctx context.Context, id uint) error
+}
+
+// VoucherRepository defines the database operations for the Voucher model.
+type VoucherRepository interface {
+	// FindAll retrieves a list of vouchers based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.VoucherFilter) ([]model.Voucher, error)
+
+	// Count returns the total number of vouchers that match the given filter.
+	Count(ctx context.Context, filter *model.VoucherFilter) (int64, error)
+
+	// FindByID retrieves a single voucher by its unique identifier, including its product restrictions.
+	FindByID(ctx context.Context, id uint) (*model.Voucher, error)
+
+	// FindByCodeForUpdate retrieves a single voucher by its code, including its product restrictions,
+	// and locks the row until the transaction ends.
+	FindByCodeForUpdate(ctx context.Context, code string) (*model.Voucher, error)
+
+	// IncrementUsedCount atomically increments the usage counter of a voucher.
+	// It reports false without changing anything when the usage limit has been reached.
+	IncrementUsedCount(ctx context.Context, id uint) (bool, error)
+
+	// ReplaceProducts replaces the product restrictions of a voucher.
+	ReplaceProducts(ctx context.Context, id uint, productIDs []uint) error
+
+	// Save persists a new voucher record to the database.
+	Save(ctx context.Context, voucher *model.Voucher) (*model.Voucher, error)
+
+	// Update modifies an existing voucher record in the database.
+	Update(ctx context.Context, voucher *model.Voucher) (*model.Voucher, error)
+
+	// Delete removes a voucher record from the database by its ID.
+	Delete(ctx context.Context, id uint) error
+}
+
+// VoucherRedemptionRepository defines the database operations for the VoucherRedemption model.
+type VoucherRedemptionRepository interface {
+	// FindAll retrieves a list of voucher redemptions based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.VoucherRedemptionFilter) ([]model.VoucherRedemption, error)
+
+	// Count returns the total number of voucher redemptions that match the given filter.
+	Count(ctx context.Context, filter *model.VoucherRedemptionFilter) (int64, error)
+
+	// Save persists a new voucher redemption record to the database.
+	Save(ctx context.Context, redemption *model.VoucherRedemption) (*model.VoucherRedemption, error)
+}
+
+// BookingPassengerRepository defines the database operations for the BookingPassenger model.
+type BookingPassengerRepository interface {
+	// FindByBookingID retrieves the passengers of a booking in the order they were listed.
+	FindByBookingID(ctx context.Context, bookingID uint) ([]model.BookingPassenger, error)
+
+	// ReplaceByBookingID removes the current passengers of a booking and persists the given ones in their place.
+	ReplaceByBookingID(ctx context.Context, bookingID uint, passengers []model.BookingPassenger) error
+}
+
+// InvoiceRepository defines the database operations for the Invoice model.
+type InvoiceRepository interface {
+	// FindAll retrieves a list of invoices based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.InvoiceFilter) ([]model.Invoice, error)
+
+	// Count returns the total number of invoices that match the given filter.
+	Count(ctx context.Context, filter *model.InvoiceFilter) (int64, error)
+
+	// FindByID retrieves a single invoice by its unique identifier, including its lines.
+	FindByID(ctx context.Context, id uint) (*model.Invoice, error)
+
+	// FindByBookingID retrieves the current invoice of a booking, including its lines.
+	FindByBookingID(ctx context.Context, bookingID uint) (*model.Invoice, error)
+
+	// Void marks an invoice as voided, once a replacement is issued for it.
+	Void(ctx context.Context, id uint, at time.Time) error
+
+	// NextSequence reserves and returns the next invoice number of the given period.
+	// Numbers are gap-free as long as the reservation and the invoice are saved in the same transaction.
+	NextSequence(ctx context.Context, period string) (int, error)
+
+	// Save persists a new invoice record with its lines to the database.
+	Save(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error)
+}
+
+// WalletRepository defines the database operations for the Wallet model.
+type WalletRepository interface {
+	// FindByUserID retrieves the wallet of a user.
+	FindByUserID(ctx context.Context, userID uint) (*model.Wallet, error)
+
+	// FindByUserIDForUpdate retrieves the wallet of a user and locks the row until the transaction ends.
+	FindByUserIDForUpdate(ctx context.Context, userID uint) (*model.Wallet, error)
+
+	// FindByIDForUpdate retrieves a single wallet by its ID and locks the row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*model.Wallet, error)
+
+	// Open persists a new empty wallet unless its user already has one.
+	Open(ctx context.Context, wallet *model.Wallet) error
+
+	// Update modifies an existing wallet record in the database.
+	Update(ctx context.Context, wallet *model.Wallet) (*model.Wallet, error)
+}
+
+// WalletTransactionRepository defines the database operations for the WalletTransaction model.
+type WalletTransactionRepository interface {
+	// FindAll retrieves a list of wallet transactions based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.WalletTransactionFilter) ([]model.WalletTransaction, error)
+
+	// Count returns the total number of wallet transactions that match the given filter.
+	Count(ctx context.Context, filter *model.WalletTransactionFilter) (int64, error)
+
+	// Save persists a new wallet transaction record with its ledger entries to the database.
+	Save(ctx context.Context, transaction *model.WalletTransaction) (*model.WalletTransaction, error)
+}
+
+// WalletHoldRepository defines the database operations for the WalletHold model.
+type WalletHoldRepository interface {
+	// FindAll retrieves a list of wallet holds based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.WalletHoldFilter) ([]model.WalletHold, error)
+
+	// Count returns the total number of wallet holds that match the given filter.
+	Count(ctx context.Context, filter *model.WalletHoldFilter) (int64, error)
+
+	// FindByID retrieves a single wallet hold by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.WalletHold, error)
+
+	// FindByIDForUpdate retrieves a single wallet hold by its ID and locks the row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*model.WalletHold, error)
+
+	// Save persists a new wallet hold record to the database.
+	Save(ctx context.Context, hold *model.WalletHold) (*model.WalletHold, error)
+
+	// Update modifies an existing wallet hold record in the database.
+	Update(ctx context.Context, hold *model.WalletHold) (*model.WalletHold, error)
+}
+
+// ReferralRepository defines the database operations for the Referral model.
+type ReferralRepository interface {
+	// FindAll retrieves a list of referrals based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.ReferralFilter) ([]model.Referral, error)
+
+	// Count returns the total number of referrals that match the given filter.
+	Count(ctx context.Context, filter *model.ReferralFilter) (int64, error)
+
+	// FindByRefereeID retrieves the referral of a referred user.
+	FindByRefereeID(ctx context.Context, refereeID uint) (*model.Referral, error)
+
+	// FindByRefereeIDForUpdate retrieves the referral of a referred user and locks the row until the transaction ends.
+	FindByRefereeIDForUpdate(ctx context.Context, refereeID uint) (*model.Referral, error)
+
+	// LockTree serializes changes to the referral tree until the transaction ends.
+	LockTree(ctx context.Context) error
+
+	// FindTree retrieves the referrals below a referrer, down to the given depth, ordered by depth.
+	FindTree(ctx context.Context, referrerID uint, maxDepth int) ([]model.ReferralTreeRow, error)
+
+	// Save persists a new referral record to the database.
+	Save(ctx context.Context, referral *model.Referral) (*model.Referral, error)
+
+	// Update modifies an existing referral record in the database.
+	Update(ctx context.Context, referral *model.Referral) (*model.Referral, error)
+}
+
+// MuthawifAssignmentRepository defines the database operations for the MuthawifAssignment model.
+type MuthawifAssignmentRepository interface {
+	// FindAll retrieves a list of muthawif assignments based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.MuthawifAssignmentFilter) ([]model.MuthawifAssignment, error)
+
+	// FindByID retrieves a single muthawif assignment by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.MuthawifAssignment, error)
+
+	// FindTrips retrieves the trips of a muthawif that have not ended before the given date, soonest first.
+	FindTrips(ctx context.Context, muthawifID uint, from time.Time, page *int, size *int) ([]model.MuthawifTrip, error)
+
+	// CountTrips returns the number of trips of a muthawif that have not ended before the given date.
+	CountTrips(ctx context.Context, muthawifID uint, from time.Time) (int64, error)
+
+	// Save persists a new muthawif assignment record to the database.
+	Save(ctx context.Context, assignment *model.MuthawifAssignment) (*model.MuthawifAssignment, error)
+
+	// SyncBatchDates copies new departure batch dates to the active assignments of the batch.
+	SyncBatchDates(ctx context.Context, batchID uint, departureDate time.Time, returnDate time.Time) error
+
+	// Delete removes a muthawif assignment record from the database by its ID.
+	Delete(ctx context.Context, id uint) error
+
+	// DeleteByDepartureBatchID removes the active assignments of a departure batch.
+	DeleteByDepartureBatchID(ctx context.Context, batchID uint) error
+}
+
+// AdminRepository defines the database operations for the Admin model.
+type AdminRepository interface {
+	// FindAll retrieves a list of admins based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.AdminFilter) ([]model.Admin, error)
+
+	// Count returns the total number of admins that match the given filter.
+	Count(ctx context.Context, filter *model.AdminFilter) (int64, error)
+
+	// FindByID retrieves a single admin by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.Admin, error)
+
+	// FindByEmail retrieves the admin with the given email address, compared case-insensitively.
+	FindByEmail(ctx context.Context, email string) (*model.Admin, error)
+
+	// FindSuperAdminsForUpdate retrieves the active super admins, locking their rows within the current transaction.
+	FindSuperAdminsForUpdate(ctx context.Context) ([]model.Admin, error)
+
+	// Save persists a new admin record to the database.
+	Save(ctx context.Context, admin *model.Admin) (*model.Admin, error)
+
+	// Update modifies an existing admin record in the database.
+	Update(ctx context.Context, admin *model.Admin) (*model.Admin, error)
+}
+
+// FinancingInstitutionRepository defines the database operations for the FinancingInstitution model.
+type FinancingInstitutionRepository interface {
+	// FindAll retrieves a list of financing institutions based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.FinancingInstitutionFilter) ([]model.FinancingInstitution, error)
+
+	// Count returns the total number of financing institutions that match the given filter.
+	Count(ctx context.Context, filter *model.FinancingInstitutionFilter) (int64, error)
+
+	// FindByID retrieves a single financing institution by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.FinancingInstitution, error)
+
+	// Save persists a new financing institution record to the database.
+	Save(ctx context.Context, institution *model.FinancingInstitution) (*model.FinancingInstitution, error)
+}
+
+// CommissionRateRepository defines the database operations for the CommissionRate model.
+type CommissionRateRepository interface {
+	// FindAll retrieves a list of commission rates based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.CommissionRateFilter) ([]model.CommissionRate, error)
+
+	// Count returns the total number of commission rates that match the given filter.
+	Count(ctx context.Context, filter *model.CommissionRateFilter) (int64, error)
+
+	// FindByID retrieves a single commission rate by its unique identifier.
+	FindByID(ctx context.Context, id uint) (*model.CommissionRate, error)
+
+	// FindApplicable retrieves the most specific commission rate for an agent selling a product.
+	FindApplicable(ctx context.Context, agentID uint, productID *uint) (*model.CommissionRate, error)
+
+	// Save persists a new commission rate record to the database.
+	Save(ctx context.Context, rate *model.CommissionRate) (*model.CommissionRate, error)
+
+	// Update modifies an existing commission rate record in the database.
+	Update(ctx context.Context, rate *model.CommissionRate) (*model.CommissionRate, error)
+
+	// Delete removes a commission rate record from the database by its ID.
+	Delete(ctx context.Context, id uint) error
+}
+
+// AgentCommissionRepository defines the database operations for the AgentCommission model.
+type AgentCommissionRepository interface {
+	// FindAll retrieves a list of agent commissions based on pagination parameters and filter criteria.
+	FindAll(ctx context.Context, page *int, size *int, filter *model.AgentCommissionFilter) ([]model.AgentCommission, error)
+
+	// Count returns the total number of agent commissions that match the given filter.
+	Count(ctx context.Context, filter *model.AgentCommissionFilter) (int64, error)
+
+	// FindByBookingID retrieves the commission earned on a booking.
+	FindByBookingID(ctx context.Context, bookingID uint) (*model.AgentCommission, error)
+
+	// FindStatements totals the commissions of an agent per period, latest period first.
+	FindStatements(ctx context.Context, agentID uint) ([]model.CommissionStatement, error)
+
+	// Save persists a new agent commission record to the database.
+	Save(ctx context.Context, commission *model.AgentCommission) (*model.AgentCommission, error)
+}
+
+// RefreshTokenRepository defines the database operations for the RefreshToken model.
+type RefreshTokenRepository interface {
+	// FindByHashForUpdate retrieves the refresh token with the given hash, used or not, and locks the row until the transaction ends.
+	FindByHashForUpdate(ctx context.Context, hash string) (*model.RefreshToken, error)
+
+	// FindActiveFamilyIDs returns the token families, that is the login sessions, of a principal that are not revoked.
+	FindActiveFamilyIDs(ctx context.Context, principalID uint, principalRole string) ([]string, error)
+
+	// Save persists a new refresh token record to the database.
+	Save(ctx context.Context, token *model.RefreshToken) (*model.RefreshToken, error)
+
+	// Update modifies an existing refresh token record in the database.
+	Update(ctx context.Context, token *model.RefreshToken) (*model.RefreshToken, error)
+
+	// RevokeFamilies revokes every token of the given families that is not revoked yet.
+	RevokeFamilies(ctx context.Context, familyIDs []string, at time.Time, by datatypes.JSON) error
+}
+
+// AccessTokenDenylistRepository defines the database operations for the AccessTokenDenial model.
+type AccessTokenDenylistRepository interface {
+	// Deny adds sessions to the denylist, extending the expiry of sessions that are already on it.
+	Deny(ctx context.Context, entries []model.AccessTokenDenial) error
+
+	// IsDenied reports whether the access tokens of the given session are denied.
+	IsDenied(ctx context.Context, sessionID string) (bool, error)
+}
+
+// EmailVerificationSendRepository defines the database operations for the EmailVerificationSend model.
+type EmailVerificationSendRepository interface {
+	// FindSince retrieves the verification emails sent to a user since the given time, the most recent first.
+	FindSince(ctx context.Context, userID uint, since time.Time) ([]model.EmailVerificationSend, error)
+
+	// Save persists a new verification email send record to the database.
+	Save(ctx context.Context, send *model.EmailVerificationSend) (*model.EmailVerificationSend, error)
+}
+
+// PasswordResetTokenRepository defines the database operations for the PasswordResetToken model.
+type PasswordResetTokenRepository interface {
+	// FindByHashForUpdate retrieves the password reset token with the given hash, used or not,
+	// locking the row within the current transaction.
+	FindByHashForUpdate(ctx context.Context, hash string) (*model.PasswordResetToken, error)
+
+	// FindLatestUnused retrieves the most recently issued unused password reset token of a user.
+	FindLatestUnused(ctx context.Context, userID uint) (*model.PasswordResetToken, error)
+
+	// Save persists a new password reset token record to the database.
+	Save(ctx context.Context, token *model.PasswordResetToken) (*model.PasswordResetToken, error)
+
+	// UseAll marks every unused password reset token of a user as used at the given time.
+	UseAll(ctx context.Context, userID uint, at time.Time) error
+}
@@ -24,3 +24,357 @@ type ProductService interface {
 	// DeleteProduct removes a product identified by its ID from the system.
 	DeleteProduct(ctx context.Context, id uint) error
 }
+
+// BookingService defines the business logic operations available for the Booking model.
+type BookingService interface {
+	// CreateBooking handles the creation of a new booking, snapshotting product and user data.
+	CreateBooking(ctx context.Context, req payload.BookingCreateRequest) (*payload.BookingBaseResponse, error)
+
+	// GetAllBookings retrieves a list of bookings matching the criteria in the request, including pagination.
+	GetAllBookings(ctx context.Context, req payload.BookingGetAllRequest) ([]payload.BookingBaseResponse, *response.Pagination, error)
+
+	// GetBookingByID retrieves the details of a specific booking identified by its ID.
+	GetBookingByID(ctx context.Context, id uint) (*payload.BookingBaseResponse, error)
+
+	// GetBookingByCode retrieves the details of a specific booking identified by its booking code.
+	GetBookingByCode(ctx context.Context, code string) (*payload.BookingBaseResponse, error)
+
+	// UpdateBooking modifies an existing booking identified by its ID with the provided update data.
+	UpdateBooking(ctx context.Context, id uint, req payload.BookingUpdateRequest) (*payload.BookingBaseResponse, error)
+
+	// DeleteBooking removes a booking identified by its ID from the system.
+	DeleteBooking(ctx context.Context, id uint) error
+
+	// ConfirmBooking moves a booking from booked to confirmed.
+	ConfirmBooking(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error)
+
+	// StartTrip moves a booking from confirmed to on-trip.
+	StartTrip(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error)
+
+	// CompleteBooking moves a booking from on-trip to done.
+	CompleteBooking(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error)
+
+	// CancelBooking cancels a booking that has not started its trip yet.
+	CancelBooking(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error)
+
+	// GetBookingStatusHistories retrieves every recorded status transition of a booking.
+	GetBookingStatusHistories(ctx context.Context, id uint) ([]payload.BookingStatusHistoryResponse, error)
+}
+
+// PaymentService defines the business logic operations available for the Payment model.
+type PaymentService interface {
+	// RecordPayment records a payment against a booking and recalculates the booking's payment state.
+	RecordPayment(ctx context.Context, bookingID uint, req payload.PaymentCreateRequest) (*payload.PaymentRecordResponse, error)
+
+	// GetBookingPayments retrieves the payments of a booking, including pagination.
+	GetBookingPayments(ctx context.Context, bookingID uint, req payload.PaymentGetAllRequest) ([]payload.PaymentBaseResponse, *response.Pagination, error)
+
+	// GetPaymentByID retrieves the details of a specific payment identified by its ID.
+	GetPaymentByID(ctx context.Context, id uint) (*payload.PaymentBaseResponse, error)
+}
+
+// InstallmentService defines the business logic operations available for booking installment plans.
+type InstallmentService interface {
+	// RequestInstallmentPlan submits an installment plan request for a booking's outstanding balance.
+	RequestInstallmentPlan(ctx context.Context, bookingID uint, req payload.InstallmentPlanCreateRequest) (*payload.InstallmentPlanResponse, error)
+
+	// ApproveInstallmentPlan approves a requested plan and generates its schedule of dues.
+	ApproveInstallmentPlan(ctx context.Context, id uint) (*payload.InstallmentPlanResponse, error)
+
+	// RejectInstallmentPlan rejects a requested plan and releases its booking.
+	RejectInstallmentPlan(ctx context.Context, id uint, req payload.InstallmentPlanRejectRequest) (*payload.InstallmentPlanResponse, error)
+
+	// GetBookingInstallmentPlan retrieves the installment plan of a booking with its dues.
+	GetBookingInstallmentPlan(ctx context.Context, bookingID uint) (*payload.InstallmentPlanResponse, error)
+
+	// GetOverdueInstallments retrieves unsettled dues past their due date, oldest first, including pagination.
+	GetOverdueInstallments(ctx context.Context, req payload.InstallmentOverdueGetAllRequest) ([]payload.InstallmentOverdueResponse, *response.Pagination, error)
+}
+
+// RefundService defines the business logic operations available for the Refund model.
+type RefundService interface {
+	// RequestRefund submits a refund request on a booking.
+	RequestRefund(ctx context.Context, bookingID uint, req payload.RefundCreateRequest) (*payload.RefundBaseResponse, error)
+
+	// GetAllRefunds retrieves a list of refunds matching the criteria in the request, including pagination.
+	GetAllRefunds(ctx context.Context, req payload.RefundGetAllRequest) ([]payload.RefundBaseResponse, *response.Pagination, error)
+
+	// GetBookingRefunds retrieves the refunds of a booking, including pagination.
+	GetBookingRefunds(ctx context.Context, bookingID uint, req payload.RefundGetAllRequest) ([]payload.RefundBaseResponse, *response.Pagination, error)
+
+	// GetRefundByID retrieves the details of a specific refund identified by its ID.
+	GetRefundByID(ctx context.Context, id uint) (*payload.RefundBaseResponse, error)
+
+	// ApproveRefund approves a requested refund.
+	ApproveRefund(ctx context.Context, id uint) (*payload.RefundBaseResponse, error)
+
+	// RejectRefund rejects a requested refund with a reason.
+	RejectRefund(ctx context.Context, id uint, req payload.RefundRejectRequest) (*payload.RefundBaseResponse, error)
+
+	// ProcessRefund marks an approved refund as processed by finance.
+	ProcessRefund(ctx context.Context, id uint, req payload.RefundProcessRequest) (*payload.RefundBaseResponse, error)
+
+	// CompleteRefund completes a processed refund and moves its booking to refunded.
+	CompleteRefund(ctx context.Context, id uint) (*payload.RefundBaseResponse, error)
+}
+
+// RescheduleService defines the business logic operations available for the Reschedule model.
+type RescheduleService interface {
+	// RequestReschedule submits a request to move a booking to another product and travel date.
+	RequestReschedule(ctx context.Context, bookingID uint, req payload.RescheduleCreateRequest) (*payload.RescheduleBaseResponse, error)
+
+	// GetAllReschedules retrieves a list of reschedules matching the criteria in the request, including pagination.
+	GetAllReschedules(ctx context.Context, req payload.RescheduleGetAllRequest) ([]payload.RescheduleBaseResponse, *response.Pagination, error)
+
+	// GetBookingReschedules retrieves the reschedules of a booking, including pagination.
+	GetBookingReschedules(ctx context.Context, bookingID uint, req payload.RescheduleGetAllRequest) ([]payload.RescheduleBaseResponse, *response.Pagination, error)
+
+	// GetRescheduleByID retrieves the details of a specific reschedule identified by its ID.
+	GetRescheduleByID(ctx context.Context, id uint) (*payload.RescheduleBaseResponse, error)
+
+	// ApproveReschedule approves a requested reschedule.
+	ApproveReschedule(ctx context.Context, id uint) (*payload.RescheduleBaseResponse, error)
+
+	// RejectReschedule rejects a requested reschedule with a reason.
+	RejectReschedule(ctx context.Context, id uint, req payload.RescheduleRejectRequest) (*payload.RescheduleBaseResponse, error)
+
+	// ProcessReschedule applies an approved reschedule to its booking.
+	ProcessReschedule(ctx context.Context, id uint) (*payload.RescheduleProcessResponse, error)
+}
+
+// DepartureBatchService defines the business logic operations available for the DepartureBatch model.
+type DepartureBatchService interface {
+	// CreateDepartureBatch schedules a new departure batch for a product.
+	CreateDepartureBatch(ctx context.Context, productID uint, req payload.DepartureBatchCreateRequest) (*payload.DepartureBatchBaseResponse, error)
+
+	// GetProductDepartureBatches retrieves the departure batches of a product, including pagination.
+	GetProductDepartureBatches(ctx context.Context, productID uint, req payload.DepartureBatchGetAllRequest) ([]payload.DepartureBatchBaseResponse, *response.Pagination, error)
+
+	// GetDepartureBatchByID retrieves the details of a specific departure batch identified by its ID.
+	GetDepartureBatchByID(ctx context.Context, id uint) (*payload.DepartureBatchBaseResponse, error)
+
+	// UpdateDepartureBatch modifies an existing departure batch identified by its ID with the provided update data.
+	UpdateDepartureBatch(ctx context.Context, id uint, req payload.DepartureBatchUpdateRequest) (*payload.DepartureBatchBaseResponse, error)
+
+	// DeleteDepartureBatch removes a departure batch that has no seats taken.
+	DeleteDepartureBatch(ctx context.Context, id uint) error
+}
+
+// VoucherService defines the business logic operations available for the Voucher model.
+type VoucherService interface {
+	// CreateVoucher handles the creation of a new voucher based on the provided request.
+	CreateVoucher(ctx context.Context, req payload.VoucherCreateRequest) (*payload.VoucherBaseResponse, error)
+
+	// GetAllVouchers retrieves a list of vouchers matching the criteria in the request, including pagination.
+	GetAllVouchers(ctx context.Context, req payload.VoucherGetAllRequest) ([]payload.VoucherBaseResponse, *response.Pagination, error)
+
+	// GetVoucherByID retrieves the details of a specific voucher identified by its ID.
+	GetVoucherByID(ctx context.Context, id uint) (*payload.VoucherBaseResponse, error)
+
+	// UpdateVoucher modifies an existing voucher identified by its ID with the provided update data.
+	UpdateVoucher(ctx context.Context, id uint, req payload.VoucherUpdateRequest) (*payload.VoucherBaseResponse, error)
+
+	// DeleteVoucher removes a voucher identified by its ID from the system.
+	DeleteVoucher(ctx context.Context, id uint) error
+
+	// GetVoucherRedemptions retrieves the redemptions of a voucher, including pagination.
+	GetVoucherRedemptions(ctx context.Context, id uint, req payload.VoucherRedemptionGetAllRequest) ([]payload.VoucherRedemptionResponse, *response.Pagination, error)
+}
+
+// BookingPassengerService defines the business logic operations available for the passenger manifest of a booking.
+type BookingPassengerService interface {
+	// ReplaceBookingPassengers replaces the passenger manifest of a booking.
+	ReplaceBookingPassengers(ctx context.Context, bookingID uint, req payload.BookingPassengerReplaceRequest) (*payload.BookingPassengerManifestResponse, error)
+
+	// GetBookingPassengers retrieves the passenger manifest of a booking.
+	GetBookingPassengers(ctx context.Context, bookingID uint) (*payload.BookingPassengerManifestResponse, error)
+}
+
+// InvoiceService defines the business logic operations available for invoices and payment receipts.
+type InvoiceService interface {
+	// GetBookingInvoice renders the invoice of a booking as a PDF, issuing the invoice on first request.
+	GetBookingInvoice(ctx context.Context, bookingID uint) (*payload.FileResponse, error)
+
+	// GetBookingReceipt renders the receipt of a payment made on a booking as a PDF.
+	GetBookingReceipt(ctx context.Context, bookingID uint, paymentID uint) (*payload.FileResponse, error)
+
+	// GetAllInvoices retrieves a list of invoices matching the criteria in the request, including pagination.
+	GetAllInvoices(ctx context.Context, req payload.InvoiceGetAllRequest) ([]payload.InvoiceBaseResponse, *response.Pagination, error)
+
+	// GetInvoiceByID retrieves the details of a specific invoice identified by its ID.
+	GetInvoiceByID(ctx context.Context, id uint) (*payload.InvoiceBaseResponse, error)
+}
+
+// WalletService defines the business logic operations available for customer wallets.
+type WalletService interface {
+	// GetUserWallet retrieves the wallet of a user with its balance.
+	GetUserWallet(ctx context.Context, userID uint) (*payload.WalletResponse, error)
+
+	// CreditUserWallet adds a top-up or cashback to the wallet of a user.
+	CreditUserWallet(ctx context.Context, userID uint, req payload.WalletCreditRequest) (*payload.WalletTransactionResponse, error)
+
+	// GetUserWalletTransactions retrieves the transactions of a user's wallet, including pagination.
+	GetUserWalletTransactions(ctx context.Context, userID uint, req payload.WalletTransactionGetAllRequest) ([]payload.WalletTransactionResponse, *response.Pagination, error)
+
+	// PlaceUserWalletHold freezes part of the available balance of a user's wallet.
+	PlaceUserWalletHold(ctx context.Context, userID uint, req payload.WalletHoldCreateRequest) (*payload.WalletHoldResponse, error)
+
+	// GetUserWalletHolds retrieves the holds of a user's wallet, including pagination.
+	GetUserWalletHolds(ctx context.Context, userID uint, req payload.WalletHoldGetAllRequest) ([]payload.WalletHoldResponse, *response.Pagination, error)
+
+	// ReleaseWalletHold releases an active hold, making its amount available again.
+	ReleaseWalletHold(ctx context.Context, id uint) (*payload.WalletHoldResponse, error)
+}
+
+// ReferralService defines the business logic operations available for the referral program.
+type ReferralService interface {
+	// GetUserReferralCode retrieves the referral code of a user, generating one on first use.
+	GetUserReferralCode(ctx context.Context, userID uint) (*payload.ReferralCodeResponse, error)
+
+	// UpdateUserReferralCode replaces the referral code of a user with a code of their choice.
+	UpdateUserReferralCode(ctx context.Context, userID uint, req payload.ReferralCodeUpdateRequest) (*payload.ReferralCodeResponse, error)
+
+	// ApplyReferralCode records that a user was referred by the owner of the given code.
+	ApplyReferralCode(ctx context.Context, userID uint, req payload.ReferralApplyRequest) (*payload.ReferralResponse, error)
+
+	// GetAllReferrals retrieves a list of referrals and their reward payouts, including pagination.
+	GetAllReferrals(ctx context.Context, req payload.ReferralGetAllRequest) ([]payload.ReferralResponse, *response.Pagination, error)
+
+	// GetUserReferralTree retrieves the users referred by a user, nested by who referred them.
+	GetUserReferralTree(ctx context.Context, userID uint, req payload.ReferralTreeRequest) (*payload.ReferralTreeResponse, error)
+}
+
+// MuthawifService defines the business logic operations available for muthawif (tour guide) assignments.
+type MuthawifService interface {
+	// AssignMuthawif assigns a muthawif to a departure batch.
+	AssignMuthawif(ctx context.Context, batchID uint, req payload.MuthawifAssignmentCreateRequest) (*payload.MuthawifAssignmentResponse, error)
+
+	// GetDepartureBatchMuthawifs retrieves the muthawif assigned to a departure batch.
+	GetDepartureBatchMuthawifs(ctx context.Context, batchID uint) ([]payload.MuthawifAssignmentResponse, error)
+
+	// UnassignMuthawif removes a muthawif assignment identified by its ID.
+	UnassignMuthawif(ctx context.Context, id uint) error
+
+	// GetMuthawifTrips retrieves the upcoming trips of a muthawif with their bookings, including pagination.
+	GetMuthawifTrips(ctx context.Context, muthawifID uint, req payload.MuthawifTripGetAllRequest) ([]payload.MuthawifTripResponse, *response.Pagination, error)
+}
+
+// AgentService defines the business logic operations available for travel agents and their commissions.
+type AgentService interface {
+	// CreateAgent creates a new travel agent account.
+	CreateAgent(ctx context.Context, req payload.AgentCreateRequest) (*payload.AgentResponse, error)
+
+	// GetAllAgents retrieves a list of travel agents, including pagination.
+	GetAllAgents(ctx context.Context, req payload.AgentGetAllRequest) ([]payload.AgentResponse, *response.Pagination, error)
+
+	// GetAgentByID retrieves the details of a specific travel agent identified by its ID.
+	GetAgentByID(ctx context.Context, id uint) (*payload.AgentResponse, error)
+
+	// UpdateAgent modifies an existing travel agent identified by its ID with the provided update data.
+	UpdateAgent(ctx context.Context, id uint, req payload.AgentUpdateRequest) (*payload.AgentResponse, error)
+
+	// CreateCommissionRate creates a new commission rate for an agent, a product or both.
+	CreateCommissionRate(ctx context.Context, req payload.CommissionRateCreateRequest) (*payload.CommissionRateResponse, error)
+
+	// GetAllCommissionRates retrieves a list of commission rates, including pagination.
+	GetAllCommissionRates(ctx context.Context, req payload.CommissionRateGetAllRequest) ([]payload.CommissionRateResponse, *response.Pagination, error)
+
+	// UpdateCommissionRate changes the percentage of a commission rate identified by its ID.
+	UpdateCommissionRate(ctx context.Context, id uint, req payload.CommissionRateUpdateRequest) (*payload.CommissionRateResponse, error)
+
+	// DeleteCommissionRate removes a commission rate identified by its ID.
+	DeleteCommissionRate(ctx context.Context, id uint) error
+
+	// GetAgentBookings retrieves the bookings attributed to an agent, including pagination.
+	GetAgentBookings(ctx context.Context, agentID uint, req payload.BookingGetAllRequest) ([]payload.BookingBaseResponse, *response.Pagination, error)
+
+	// GetAgentCommissions retrieves the commissions earned by an agent, including pagination.
+	GetAgentCommissions(ctx context.Context, agentID uint, req payload.AgentCommissionGetAllRequest) ([]payload.AgentCommissionResponse, *response.Pagination, error)
+
+	// GetAgentCommissionStatements retrieves the commission totals of an agent per period.
+	GetAgentCommissionStatements(ctx context.Context, agentID uint) ([]payload.CommissionStatementResponse, error)
+}
+
+// FinancingService defines the business logic operations available to partner financing institutions.
+type FinancingService interface {
+	// CreateFinancingInstitution registers a financing institution that requests can be routed to.
+	CreateFinancingInstitution(ctx context.Context, req payload.FinancingInstitutionCreateRequest) (*payload.FinancingInstitutionResponse, error)
+
+	// GetAllFinancingInstitutions retrieves a list of financing institutions matching the criteria in the request, including pagination.
+	GetAllFinancingInstitutions(ctx context.Context, req payload.FinancingInstitutionGetAllRequest) ([]payload.FinancingInstitutionResponse, *response.Pagination, error)
+
+	// GetInstitutionRequests retrieves the financing requests routed to an institution, including pagination.
+	GetInstitutionRequests(ctx context.Context, institutionID uint, req payload.FinancingRequestGetAllRequest) ([]payload.InstallmentPlanResponse, *response.Pagination, error)
+
+	// GetFinancingRequest retrieves the details of a specific financing request identified by its ID.
+	GetFinancingRequest(ctx context.Context, id uint) (*payload.InstallmentPlanResponse, error)
+
+	// ApproveFinancingRequest approves a financing request under the terms set by the institution.
+	ApproveFinancingRequest(ctx context.Context, id uint, req payload.FinancingApproveRequest) (*payload.InstallmentPlanResponse, error)
+
+	// RejectFinancingRequest rejects a financing request with a reason.
+	RejectFinancingRequest(ctx context.Context, id uint, req payload.FinancingRejectRequest) (*payload.InstallmentPlanResponse, error)
+
+	// RecordDisbursement records the payout of an approved financing request as a payment against its booking.
+	RecordDisbursement(ctx context.Context, id uint, req payload.FinancingDisbursementCreateRequest) (*payload.FinancingDisbursementResponse, error)
+}
+
+// UserService defines the business logic operations available for the User model.
+type UserService interface {
+	// RegisterUser creates a new customer account, optionally referred by the owner of a referral code.
+	RegisterUser(ctx context.Context, req payload.UserRegisterRequest) (*payload.UserResponse, error)
+
+	// GetAllUsers retrieves a list of users matching the criteria in the request, including pagination.
+	GetAllUsers(ctx context.Context, req payload.UserGetAllRequest) ([]payload.UserResponse, *response.Pagination, error)
+
+	// GetUserByID retrieves the profile of a specific user identified by its ID.
+	GetUserByID(ctx context.Context, id uint) (*payload.UserResponse, error)
+
+	// UpdateUser modifies the profile of an existing user identified by its ID with the provided update data.
+	UpdateUser(ctx context.Context, id uint, req payload.UserUpdateRequest) (*payload.UserResponse, error)
+
+	// VerifyEmail verifies a user's email address with the token from a verification link.
+	VerifyEmail(ctx context.Context, req payload.UserVerifyEmailRequest) (*payload.UserResponse, error)
+
+	// ResendVerificationEmail sends a new verification link to the unverified email address of a user identified by its ID.
+	ResendVerificationEmail(ctx context.Context, id uint) error
+
+	// ForgotPassword emails a password reset link to the user with the given email address, if there is one.
+	ForgotPassword(ctx context.Context, req payload.UserForgotPasswordRequest) error
+
+	// ResetPassword sets a new password with the token from a reset link and ends the user's sessions.
+	ResetPassword(ctx context.Context, req payload.UserResetPasswordRequest) error
+}
+
+// AuthService defines the business logic operations available for authenticating users and admins.
+type AuthService interface {
+	// Login verifies the credentials of a user and starts a session with a new pair of tokens.
+	Login(ctx context.Context, req payload.LoginRequest) (*payload.TokenResponse, error)
+
+	// AdminLogin verifies the credentials of an admin and starts a session with a new pair of tokens.
+	AdminLogin(ctx context.Context, req payload.AdminLoginRequest) (*payload.TokenResponse, error)
+
+	// Refresh exchanges a refresh token for a new pair of tokens of the same session.
+	Refresh(ctx context.Context, req payload.RefreshTokenRequest) (*payload.TokenResponse, error)
+
+	// Logout ends the session of the current access token.
+	Logout(ctx context.Context) error
+
+	// LogoutAll ends every session of the current principal.
+	LogoutAll(ctx context.Context) error
+}
+
+// AdminService defines the business logic operations available for managing admin accounts.
+type AdminService interface {
+	// CreateAdmin creates a new admin account.
+	CreateAdmin(ctx context.Context, req payload.AdminCreateRequest) (*payload.AdminResponse, error)
+
+	// GetAllAdmins retrieves a list of admin accounts matching the criteria in the request, including pagination.
+	GetAllAdmins(ctx context.Context, req payload.AdminGetAllRequest) ([]payload.AdminResponse, *response.Pagination, error)
+
+	// GetAdminByID retrieves a specific admin account identified by its ID.
+	GetAdminByID(ctx context.Context, id uint) (*payload.AdminResponse, error)
+
+	// UpdateAdmin modifies, disables or re-roles an existing admin account identified by its ID.
+	UpdateAdmin(ctx context.Context, id uint, req payload.AdminUpdateRequest) (*payload.AdminResponse, error)
+}
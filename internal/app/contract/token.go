@@ -0,0 +1,27 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+)
+
+// AccessTokenManager defines the contract for issuing and validating the signed access tokens
+// that authenticate API requests.
+type AccessTokenManager interface {
+	// Issue returns a signed access token for the given principal and the time it expires at.
+	Issue(principal actor.Actor) (string, time.Time, error)
+
+	// Parse validates a signed access token and returns the principal it was issued for.
+	Parse(token string) (actor.Actor, error)
+}
+
+// SignedTokenManager defines the contract for the signed, expiring tokens embedded in links sent to users,
+// such as email verification links. A token is bound to a purpose and cannot be used for another one.
+type SignedTokenManager interface {
+	// Sign returns a token carrying the subject for the given purpose, valid for ttl, and the time it expires at.
+	Sign(purpose, subject string, ttl time.Duration) (string, time.Time, error)
+
+	// Verify validates a token issued for the given purpose and returns its subject.
+	Verify(purpose, token string) (string, error)
+}
@@ -7,6 +7,32 @@ import (
 // UnitOfWork defines the interface for managing atomic database operations (transactions) and provides access to repositories.
 type UnitOfWork interface {
 	ProductRepository() ProductRepository
+	UserRepository() UserRepository
+	BookingRepository() BookingRepository
+	BookingStatusHistoryRepository() BookingStatusHistoryRepository
+	PaymentRepository() PaymentRepository
+	InstallmentPlanRepository() InstallmentPlanRepository
+	InstallmentDueRepository() InstallmentDueRepository
+	RefundRepository() RefundRepository
+	RescheduleRepository() RescheduleRepository
+	DepartureBatchRepository() DepartureBatchRepository
+	VoucherRepository() VoucherRepository
+	VoucherRedemptionRepository() VoucherRedemptionRepository
+	BookingPassengerRepository() BookingPassengerRepository
+	InvoiceRepository() InvoiceRepository
+	WalletRepository() WalletRepository
+	WalletTransactionRepository() WalletTransactionRepository
+	WalletHoldRepository() WalletHoldRepository
+	ReferralRepository() ReferralRepository
+	MuthawifAssignmentRepository() MuthawifAssignmentRepository
+	AdminRepository() AdminRepository
+	FinancingInstitutionRepository() FinancingInstitutionRepository
+	CommissionRateRepository() CommissionRateRepository
+	AgentCommissionRepository() AgentCommissionRepository
+	RefreshTokenRepository() RefreshTokenRepository
+	AccessTokenDenylistRepository() AccessTokenDenylistRepository
+	EmailVerificationSendRepository() EmailVerificationSendRepository
+	PasswordResetTokenRepository() PasswordResetTokenRepository
 
 	// RunInTransaction runs the given function 'fn' within a single atomic transaction.
 	// If 'fn' returns an error, the transaction is rolled back.
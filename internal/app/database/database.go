@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aburizalpurnama/travel/internal/config"
+	"github.com/aburizalpurnama/travel/internal/pkg/gormhelper"
 	"github.com/jmoiron/sqlx"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -46,6 +47,12 @@ func NewGorm(cfg *config.Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	// Fill the audit columns of every write from the actor in the request context
+	err = db.Use(gormhelper.AuditPlugin{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register audit plugin: %w", err)
+	}
+
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get native sql.DB: %w", err)
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upBookingStatusHistories, downBookingStatusHistories)
+}
+
+func upBookingStatusHistories(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "transaction"."booking_status_histories" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "booking_id" int NOT NULL,
+    "from_status" "transaction"."bookings_status_enum" DEFAULT NULL,
+    "to_status" "transaction"."bookings_status_enum" NOT NULL,
+    "reason" text DEFAULT NULL,
+    CONSTRAINT fk_booking_status_histories_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_booking_status_histories_uid_active ON "transaction"."booking_status_histories" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_booking_status_histories_booking_id ON "transaction"."booking_status_histories" ("booking_id");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upBookingStatusHistories: %w", err)
+	}
+	return nil
+}
+
+func downBookingStatusHistories(ctx context.Context, tx *sql.Tx) error {
+	query := `DROP TABLE IF EXISTS "transaction"."booking_status_histories" CASCADE;`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downBookingStatusHistories: %w", err)
+	}
+	return nil
+}
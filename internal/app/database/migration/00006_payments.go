@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upPayments, downPayments)
+}
+
+func upPayments(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "transaction"."payments" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "booking_id" int NOT NULL,
+    "amount" decimal(18,2) NOT NULL,
+    "method" varchar(50) NOT NULL,
+    "reference" varchar(255) DEFAULT NULL,
+    "paid_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "notes" text DEFAULT NULL,
+    CONSTRAINT fk_payments_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT ck_payments_amount_positive CHECK ("amount" > 0)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_payments_uid_active ON "transaction"."payments" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_payments_booking_id ON "transaction"."payments" ("booking_id");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upPayments: %w", err)
+	}
+	return nil
+}
+
+func downPayments(ctx context.Context, tx *sql.Tx) error {
+	query := `DROP TABLE IF EXISTS "transaction"."payments" CASCADE;`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downPayments: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upBookingCodeUnique, downBookingCodeUnique)
+}
+
+func upBookingCodeUnique(ctx context.Context, tx *sql.Tx) error {
+	query := `CREATE UNIQUE INDEX IF NOT EXISTS ux_bookings_code_active ON "transaction"."bookings" ("code") WHERE "deleted_on" IS NULL;`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upBookingCodeUnique: %w", err)
+	}
+	return nil
+}
+
+func downBookingCodeUnique(ctx context.Context, tx *sql.Tx) error {
+	query := `DROP INDEX IF EXISTS "transaction".ux_bookings_code_active;`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downBookingCodeUnique: %w", err)
+	}
+	return nil
+}
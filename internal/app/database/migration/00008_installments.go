@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInstallments, downInstallments)
+}
+
+func upInstallments(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  ALTER TABLE "transaction"."bookings"
+    ADD COLUMN IF NOT EXISTS "installment_request_status" "transaction"."bookings_installment_request_status_enum" DEFAULT NULL;
+
+  CREATE TABLE IF NOT EXISTS "transaction"."installment_plans" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "booking_id" int NOT NULL,
+    "status" "transaction"."bookings_installment_request_status_enum" NOT NULL DEFAULT 'requested',
+    "terms" int NOT NULL,
+    "interval_months" int NOT NULL DEFAULT 1,
+    "first_due_date" date NOT NULL,
+    "amount" decimal(18,2) NOT NULL,
+    "approved_by" jsonb DEFAULT NULL,
+    "approved_on" timestamptz DEFAULT NULL,
+    CONSTRAINT fk_installment_plans_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT ck_installment_plans_terms CHECK ("terms" > 0),
+    CONSTRAINT ck_installment_plans_interval_months CHECK ("interval_months" > 0)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_installment_plans_uid_active ON "transaction"."installment_plans" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_installment_plans_booking_id_active ON "transaction"."installment_plans" ("booking_id") WHERE "deleted_on" IS NULL;
+
+  CREATE TABLE IF NOT EXISTS "transaction"."installment_dues" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "plan_id" int NOT NULL,
+    "booking_id" int NOT NULL,
+    "sequence" int NOT NULL,
+    "due_date" date NOT NULL,
+    "amount" decimal(18,2) NOT NULL,
+    "paid_amount" decimal(18,2) NOT NULL DEFAULT 0,
+    "paid_on" timestamptz DEFAULT NULL,
+    CONSTRAINT fk_installment_dues_plan_id FOREIGN KEY ("plan_id") REFERENCES "transaction"."installment_plans" ("id"),
+    CONSTRAINT fk_installment_dues_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT ck_installment_dues_paid_amount CHECK ("paid_amount" >= 0 AND "paid_amount" <= "amount")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_installment_dues_uid_active ON "transaction"."installment_dues" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_installment_dues_plan_sequence_active ON "transaction"."installment_dues" ("plan_id", "sequence") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_installment_dues_due_date_outstanding ON "transaction"."installment_dues" ("due_date") WHERE "deleted_on" IS NULL AND "paid_amount" < "amount";
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upInstallments: %w", err)
+	}
+	return nil
+}
+
+func downInstallments(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TABLE IF EXISTS "transaction"."installment_dues" CASCADE;
+  DROP TABLE IF EXISTS "transaction"."installment_plans" CASCADE;
+  ALTER TABLE "transaction"."bookings" DROP COLUMN IF EXISTS "installment_request_status";
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downInstallments: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upRefunds, downRefunds)
+}
+
+func upRefunds(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "core"."refunds" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "booking_id" int NOT NULL,
+    "amount" decimal(18,2) NOT NULL,
+    "status" "core"."refunds_status_enum" NOT NULL DEFAULT 'requested',
+    "reason" text DEFAULT NULL,
+    "rejection_reason" text DEFAULT NULL,
+    "reference" varchar(255) DEFAULT NULL,
+    "reviewed_by" jsonb DEFAULT NULL,
+    "reviewed_on" timestamptz DEFAULT NULL,
+    "processed_by" jsonb DEFAULT NULL,
+    "processed_on" timestamptz DEFAULT NULL,
+    "completed_by" jsonb DEFAULT NULL,
+    "completed_on" timestamptz DEFAULT NULL,
+    CONSTRAINT fk_refunds_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT ck_refunds_amount_positive CHECK ("amount" > 0)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_refunds_uid_active ON "core"."refunds" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_refunds_status ON "core"."refunds" ("status");
+
+  -- A booking can only have one refund in flight; rejected refunds may be requested again
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_refunds_booking_id_open ON "core"."refunds" ("booking_id")
+    WHERE "deleted_on" IS NULL AND "status" <> 'rejected';
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upRefunds: %w", err)
+	}
+	return nil
+}
+
+func downRefunds(ctx context.Context, tx *sql.Tx) error {
+	query := `DROP TABLE IF EXISTS "core"."refunds" CASCADE;`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downRefunds: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upReschedules, downReschedules)
+}
+
+func upReschedules(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "core"."reschedules" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "booking_id" int NOT NULL,
+    "status" "core"."reschedules_status_enum" NOT NULL DEFAULT 'requested',
+    "from_product_id" int DEFAULT NULL,
+    "from_product_name" varchar(255) DEFAULT NULL,
+    "from_date" timestamptz NOT NULL,
+    "from_total_amount" decimal(18,2) NOT NULL,
+    "to_product_id" int NOT NULL,
+    "to_product_name" varchar(255) NOT NULL,
+    "to_date" timestamptz NOT NULL,
+    "to_total_amount" decimal(18,2) NOT NULL,
+    "price_difference" decimal(18,2) NOT NULL DEFAULT 0,
+    "reason" text DEFAULT NULL,
+    "rejection_reason" text DEFAULT NULL,
+    "reviewed_by" jsonb DEFAULT NULL,
+    "reviewed_on" timestamptz DEFAULT NULL,
+    "processed_by" jsonb DEFAULT NULL,
+    "processed_on" timestamptz DEFAULT NULL,
+    CONSTRAINT fk_reschedules_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT fk_reschedules_from_product_id FOREIGN KEY ("from_product_id") REFERENCES "core"."products" ("id"),
+    CONSTRAINT fk_reschedules_to_product_id FOREIGN KEY ("to_product_id") REFERENCES "core"."products" ("id")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_reschedules_uid_active ON "core"."reschedules" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_reschedules_status ON "core"."reschedules" ("status");
+
+  -- A booking can only have one reschedule awaiting a decision or processing at a time
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_reschedules_booking_id_open ON "core"."reschedules" ("booking_id")
+    WHERE "deleted_on" IS NULL AND "status" IN ('requested', 'approved');
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upReschedules: %w", err)
+	}
+	return nil
+}
+
+func downReschedules(ctx context.Context, tx *sql.Tx) error {
+	query := `DROP TABLE IF EXISTS "core"."reschedules" CASCADE;`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downReschedules: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upDepartureBatches, downDepartureBatches)
+}
+
+func upDepartureBatches(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "core"."departure_batches" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "product_id" int NOT NULL,
+    "departure_date" date NOT NULL,
+    "return_date" date NOT NULL,
+    "quota" int NOT NULL,
+    "seats_taken" int NOT NULL DEFAULT 0,
+    "is_active" boolean NOT NULL DEFAULT true,
+    CONSTRAINT fk_departure_batches_product_id FOREIGN KEY ("product_id") REFERENCES "core"."products" ("id"),
+    CONSTRAINT ck_departure_batches_dates CHECK ("return_date" >= "departure_date"),
+    CONSTRAINT ck_departure_batches_quota CHECK ("quota" > 0),
+    CONSTRAINT ck_departure_batches_seats_taken CHECK ("seats_taken" >= 0 AND "seats_taken" <= "quota")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_departure_batches_uid_active ON "core"."departure_batches" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_departure_batches_product_id ON "core"."departure_batches" ("product_id", "departure_date");
+
+  ALTER TABLE "transaction"."bookings"
+    ADD COLUMN IF NOT EXISTS "departure_batch_id" int DEFAULT NULL,
+    ADD CONSTRAINT fk_bookings_departure_batch_id FOREIGN KEY ("departure_batch_id") REFERENCES "core"."departure_batches" ("id");
+
+  CREATE INDEX IF NOT EXISTS ix_bookings_departure_batch_id ON "transaction"."bookings" ("departure_batch_id");
+
+  ALTER TABLE "core"."reschedules"
+    ADD COLUMN IF NOT EXISTS "from_departure_batch_id" int DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "to_departure_batch_id" int DEFAULT NULL,
+    ADD CONSTRAINT fk_reschedules_from_departure_batch_id FOREIGN KEY ("from_departure_batch_id") REFERENCES "core"."departure_batches" ("id"),
+    ADD CONSTRAINT fk_reschedules_to_departure_batch_id FOREIGN KEY ("to_departure_batch_id") REFERENCES "core"."departure_batches" ("id");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upDepartureBatches: %w", err)
+	}
+	return nil
+}
+
+func downDepartureBatches(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  ALTER TABLE "core"."reschedules"
+    DROP COLUMN IF EXISTS "from_departure_batch_id",
+    DROP COLUMN IF EXISTS "to_departure_batch_id";
+
+  ALTER TABLE "transaction"."bookings" DROP COLUMN IF EXISTS "departure_batch_id";
+
+  DROP TABLE IF EXISTS "core"."departure_batches" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downDepartureBatches: %w", err)
+	}
+	return nil
+}
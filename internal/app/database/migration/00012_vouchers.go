@@ -0,0 +1,103 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upVouchers, downVouchers)
+}
+
+func upVouchers(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "core"."vouchers" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "code" varchar(50) NOT NULL,
+    "name" varchar(255) NOT NULL,
+    "description" text DEFAULT NULL,
+    "discount_type" varchar(20) NOT NULL,
+    "discount_value" decimal(18,2) NOT NULL,
+    "max_discount" decimal(18,2) DEFAULT NULL,
+    "min_order_amount" decimal(18,2) NOT NULL DEFAULT 0,
+    "valid_from" timestamptz NOT NULL,
+    "valid_until" timestamptz NOT NULL,
+    "usage_limit" int DEFAULT NULL,
+    "per_user_limit" int DEFAULT NULL,
+    "used_count" int NOT NULL DEFAULT 0,
+    "is_active" boolean NOT NULL DEFAULT true,
+    CONSTRAINT ck_vouchers_discount_type CHECK ("discount_type" IN ('percentage', 'fixed')),
+    CONSTRAINT ck_vouchers_discount_value CHECK ("discount_value" > 0 AND ("discount_type" <> 'percentage' OR "discount_value" <= 100)),
+    CONSTRAINT ck_vouchers_validity CHECK ("valid_until" > "valid_from"),
+    CONSTRAINT ck_vouchers_used_count CHECK ("used_count" >= 0 AND ("usage_limit" IS NULL OR "used_count" <= "usage_limit"))
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_vouchers_uid_active ON "core"."vouchers" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_vouchers_code_active ON "core"."vouchers" ("code") WHERE "deleted_on" IS NULL;
+
+  -- Products a voucher is restricted to; a voucher without rows applies to every product
+  CREATE TABLE IF NOT EXISTS "core"."voucher_products" (
+    "voucher_id" int NOT NULL,
+    "product_id" int NOT NULL,
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("voucher_id", "product_id"),
+    CONSTRAINT fk_voucher_products_voucher_id FOREIGN KEY ("voucher_id") REFERENCES "core"."vouchers" ("id") ON DELETE CASCADE,
+    CONSTRAINT fk_voucher_products_product_id FOREIGN KEY ("product_id") REFERENCES "core"."products" ("id")
+  );
+
+  CREATE TABLE IF NOT EXISTS "core"."voucher_redemptions" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "voucher_id" int NOT NULL,
+    "booking_id" int NOT NULL,
+    "user_id" int NOT NULL,
+    "discount_amount" decimal(18,2) NOT NULL,
+    CONSTRAINT fk_voucher_redemptions_voucher_id FOREIGN KEY ("voucher_id") REFERENCES "core"."vouchers" ("id"),
+    CONSTRAINT fk_voucher_redemptions_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT fk_voucher_redemptions_user_id FOREIGN KEY ("user_id") REFERENCES "user"."users" ("id")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_voucher_redemptions_uid_active ON "core"."voucher_redemptions" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_voucher_redemptions_booking_id_active ON "core"."voucher_redemptions" ("booking_id") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_voucher_redemptions_voucher_user ON "core"."voucher_redemptions" ("voucher_id", "user_id");
+
+  ALTER TABLE "transaction"."bookings"
+    ADD COLUMN IF NOT EXISTS "voucher_id" int DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "discount_amount" decimal(18,2) NOT NULL DEFAULT 0,
+    ADD CONSTRAINT fk_bookings_voucher_id FOREIGN KEY ("voucher_id") REFERENCES "core"."vouchers" ("id");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upVouchers: %w", err)
+	}
+	return nil
+}
+
+func downVouchers(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  ALTER TABLE "transaction"."bookings"
+    DROP COLUMN IF EXISTS "voucher_id",
+    DROP COLUMN IF EXISTS "discount_amount";
+
+  DROP TABLE IF EXISTS "core"."voucher_redemptions" CASCADE;
+  DROP TABLE IF EXISTS "core"."voucher_products" CASCADE;
+  DROP TABLE IF EXISTS "core"."vouchers" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downVouchers: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upBookingPassengers, downBookingPassengers)
+}
+
+func upBookingPassengers(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TYPE IF EXISTS "transaction".booking_passengers_relationship_enum;
+  CREATE TYPE "transaction".booking_passengers_relationship_enum AS ENUM ('self','spouse','child','parent','sibling','relative','other');
+
+  CREATE TABLE IF NOT EXISTS "transaction"."booking_passengers" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "booking_id" int NOT NULL,
+    "full_name" varchar(255) NOT NULL,
+    "gender" "user"."customer_gender_enum" NOT NULL,
+    "date_of_birth" date NOT NULL,
+    "passport_number" varchar(50) NOT NULL,
+    "passport_expiry" date NOT NULL,
+    "relationship" "transaction"."booking_passengers_relationship_enum" NOT NULL,
+    CONSTRAINT fk_booking_passengers_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_booking_passengers_uid_active ON "transaction"."booking_passengers" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_booking_passengers_booking_passport_active ON "transaction"."booking_passengers" ("booking_id", "passport_number") WHERE "deleted_on" IS NULL;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upBookingPassengers: %w", err)
+	}
+	return nil
+}
+
+func downBookingPassengers(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TABLE IF EXISTS "transaction"."booking_passengers" CASCADE;
+  DROP TYPE IF EXISTS "transaction".booking_passengers_relationship_enum CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downBookingPassengers: %w", err)
+	}
+	return nil
+}
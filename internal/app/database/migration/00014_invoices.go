@@ -0,0 +1,94 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInvoices, downInvoices)
+}
+
+func upInvoices(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "transaction"."invoice_sequences" (
+    "period" varchar(7) PRIMARY KEY,
+    "last_number" int NOT NULL,
+    "modified_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT ck_invoice_sequences_last_number CHECK ("last_number" > 0)
+  );
+
+  CREATE TABLE IF NOT EXISTS "transaction"."invoices" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "booking_id" int NOT NULL,
+    "number" varchar(50) NOT NULL,
+    "period" varchar(7) NOT NULL,
+    "sequence" int NOT NULL,
+    "issued_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "booking_code" varchar(100) NOT NULL,
+    "customer_name" varchar(255) NOT NULL,
+    "subtotal" decimal(18,2) NOT NULL,
+    "discount_amount" decimal(18,2) NOT NULL DEFAULT 0,
+    "tax_percent" decimal(5,2) NOT NULL DEFAULT 0,
+    "tax_amount" decimal(18,2) NOT NULL DEFAULT 0,
+    "total_amount" decimal(18,2) NOT NULL,
+    CONSTRAINT fk_invoices_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT fk_invoices_period FOREIGN KEY ("period") REFERENCES "transaction"."invoice_sequences" ("period")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_invoices_uid_active ON "transaction"."invoices" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_invoices_booking_id_active ON "transaction"."invoices" ("booking_id") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_invoices_number ON "transaction"."invoices" ("number");
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_invoices_period_sequence ON "transaction"."invoices" ("period", "sequence");
+
+  CREATE TABLE IF NOT EXISTS "transaction"."invoice_lines" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "invoice_id" int NOT NULL,
+    "line_no" int NOT NULL,
+    "product_id" int DEFAULT NULL,
+    "description" varchar(255) NOT NULL,
+    "quantity" int NOT NULL,
+    "unit_price" decimal(18,2) NOT NULL,
+    "discount_amount" decimal(18,2) NOT NULL DEFAULT 0,
+    "tax_amount" decimal(18,2) NOT NULL DEFAULT 0,
+    "total_amount" decimal(18,2) NOT NULL,
+    CONSTRAINT fk_invoice_lines_invoice_id FOREIGN KEY ("invoice_id") REFERENCES "transaction"."invoices" ("id"),
+    CONSTRAINT fk_invoice_lines_product_id FOREIGN KEY ("product_id") REFERENCES "core"."products" ("id"),
+    CONSTRAINT ck_invoice_lines_quantity CHECK ("quantity" > 0)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_invoice_lines_uid_active ON "transaction"."invoice_lines" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_invoice_lines_invoice_line_no_active ON "transaction"."invoice_lines" ("invoice_id", "line_no") WHERE "deleted_on" IS NULL;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upInvoices: %w", err)
+	}
+	return nil
+}
+
+func downInvoices(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TABLE IF EXISTS "transaction"."invoice_lines" CASCADE;
+  DROP TABLE IF EXISTS "transaction"."invoices" CASCADE;
+  DROP TABLE IF EXISTS "transaction"."invoice_sequences" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downInvoices: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upWallets, downWallets)
+}
+
+func upWallets(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TYPE IF EXISTS "transaction".wallet_transactions_type_enum;
+  CREATE TYPE "transaction".wallet_transactions_type_enum AS ENUM ('top_up','refund','cashback','payment');
+
+  DROP TYPE IF EXISTS "transaction".wallet_entries_direction_enum;
+  CREATE TYPE "transaction".wallet_entries_direction_enum AS ENUM ('debit','credit');
+
+  DROP TYPE IF EXISTS "transaction".wallet_holds_status_enum;
+  CREATE TYPE "transaction".wallet_holds_status_enum AS ENUM ('active','released');
+
+  DROP TYPE IF EXISTS "core".refunds_destination_enum;
+  CREATE TYPE "core".refunds_destination_enum AS ENUM ('bank','wallet');
+
+  CREATE TABLE IF NOT EXISTS "transaction"."wallets" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "user_id" int NOT NULL,
+    "balance" decimal(18,2) NOT NULL DEFAULT 0,
+    "held_amount" decimal(18,2) NOT NULL DEFAULT 0,
+    CONSTRAINT fk_wallets_user_id FOREIGN KEY ("user_id") REFERENCES "user"."users" ("id"),
+    CONSTRAINT ck_wallets_balance CHECK ("balance" >= 0),
+    CONSTRAINT ck_wallets_held_amount CHECK ("held_amount" >= 0 AND "held_amount" <= "balance")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_wallets_uid_active ON "transaction"."wallets" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_wallets_user_id_active ON "transaction"."wallets" ("user_id") WHERE "deleted_on" IS NULL;
+
+  CREATE TABLE IF NOT EXISTS "transaction"."wallet_transactions" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "wallet_id" int NOT NULL,
+    "type" "transaction"."wallet_transactions_type_enum" NOT NULL,
+    "direction" "transaction"."wallet_entries_direction_enum" NOT NULL,
+    "amount" decimal(18,2) NOT NULL,
+    "balance_after" decimal(18,2) NOT NULL,
+    "booking_id" int DEFAULT NULL,
+    "payment_id" int DEFAULT NULL,
+    "refund_id" int DEFAULT NULL,
+    "reference" varchar(255) DEFAULT NULL,
+    "description" text DEFAULT NULL,
+    CONSTRAINT fk_wallet_transactions_wallet_id FOREIGN KEY ("wallet_id") REFERENCES "transaction"."wallets" ("id"),
+    CONSTRAINT fk_wallet_transactions_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT fk_wallet_transactions_payment_id FOREIGN KEY ("payment_id") REFERENCES "transaction"."payments" ("id"),
+    CONSTRAINT fk_wallet_transactions_refund_id FOREIGN KEY ("refund_id") REFERENCES "core"."refunds" ("id"),
+    CONSTRAINT ck_wallet_transactions_amount CHECK ("amount" > 0)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_wallet_transactions_uid_active ON "transaction"."wallet_transactions" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_wallet_transactions_refund_id ON "transaction"."wallet_transactions" ("refund_id") WHERE "refund_id" IS NOT NULL;
+  CREATE INDEX IF NOT EXISTS ix_wallet_transactions_wallet_id ON "transaction"."wallet_transactions" ("wallet_id", "id");
+
+  CREATE TABLE IF NOT EXISTS "transaction"."wallet_entries" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "transaction_id" int NOT NULL,
+    "account" varchar(50) NOT NULL,
+    "wallet_id" int DEFAULT NULL,
+    "direction" "transaction"."wallet_entries_direction_enum" NOT NULL,
+    "amount" decimal(18,2) NOT NULL,
+    CONSTRAINT fk_wallet_entries_transaction_id FOREIGN KEY ("transaction_id") REFERENCES "transaction"."wallet_transactions" ("id"),
+    CONSTRAINT fk_wallet_entries_wallet_id FOREIGN KEY ("wallet_id") REFERENCES "transaction"."wallets" ("id"),
+    CONSTRAINT ck_wallet_entries_amount CHECK ("amount" > 0),
+    CONSTRAINT ck_wallet_entries_wallet_account CHECK (("account" = 'wallet') = ("wallet_id" IS NOT NULL))
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_wallet_entries_uid_active ON "transaction"."wallet_entries" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_wallet_entries_transaction_id ON "transaction"."wallet_entries" ("transaction_id");
+
+  -- Every wallet transaction must have balanced debits and credits once its transaction commits
+  CREATE OR REPLACE FUNCTION "transaction".check_wallet_transaction_balanced() RETURNS trigger AS $$
+  BEGIN
+    IF (SELECT COALESCE(SUM(CASE WHEN "direction" = 'debit' THEN "amount" ELSE -"amount" END), 0)
+        FROM "transaction"."wallet_entries"
+        WHERE "transaction_id" = NEW."transaction_id" AND "deleted_on" IS NULL) <> 0 THEN
+      RAISE EXCEPTION 'wallet transaction % is not balanced', NEW."transaction_id";
+    END IF;
+    RETURN NULL;
+  END;
+  $$ LANGUAGE plpgsql;
+
+  DROP TRIGGER IF EXISTS tg_wallet_entries_balanced ON "transaction"."wallet_entries";
+  CREATE CONSTRAINT TRIGGER tg_wallet_entries_balanced
+    AFTER INSERT OR UPDATE ON "transaction"."wallet_entries"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION "transaction".check_wallet_transaction_balanced();
+
+  CREATE TABLE IF NOT EXISTS "transaction"."wallet_holds" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "wallet_id" int NOT NULL,
+    "amount" decimal(18,2) NOT NULL,
+    "status" "transaction"."wallet_holds_status_enum" NOT NULL DEFAULT 'active',
+    "reason" text NOT NULL,
+    "released_by" jsonb DEFAULT NULL,
+    "released_on" timestamptz DEFAULT NULL,
+    CONSTRAINT fk_wallet_holds_wallet_id FOREIGN KEY ("wallet_id") REFERENCES "transaction"."wallets" ("id"),
+    CONSTRAINT ck_wallet_holds_amount CHECK ("amount" > 0)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_wallet_holds_uid_active ON "transaction"."wallet_holds" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_wallet_holds_wallet_id ON "transaction"."wallet_holds" ("wallet_id");
+
+  ALTER TABLE "core"."refunds"
+    ADD COLUMN IF NOT EXISTS "destination" "core"."refunds_destination_enum" NOT NULL DEFAULT 'bank';
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upWallets: %w", err)
+	}
+	return nil
+}
+
+func downWallets(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  ALTER TABLE "core"."refunds" DROP COLUMN IF EXISTS "destination";
+  DROP TABLE IF EXISTS "transaction"."wallet_holds" CASCADE;
+  DROP TABLE IF EXISTS "transaction"."wallet_entries" CASCADE;
+  DROP FUNCTION IF EXISTS "transaction".check_wallet_transaction_balanced();
+  DROP TABLE IF EXISTS "transaction"."wallet_transactions" CASCADE;
+  DROP TABLE IF EXISTS "transaction"."wallets" CASCADE;
+  DROP TYPE IF EXISTS "core".refunds_destination_enum CASCADE;
+  DROP TYPE IF EXISTS "transaction".wallet_holds_status_enum CASCADE;
+  DROP TYPE IF EXISTS "transaction".wallet_entries_direction_enum CASCADE;
+  DROP TYPE IF EXISTS "transaction".wallet_transactions_type_enum CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downWallets: %w", err)
+	}
+	return nil
+}
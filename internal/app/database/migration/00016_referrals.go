@@ -0,0 +1,73 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upReferrals, downReferrals)
+}
+
+func upReferrals(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  ALTER TYPE "transaction".wallet_transactions_type_enum ADD VALUE IF NOT EXISTS 'referral_reward';
+
+  ALTER TABLE "user"."users"
+    ADD COLUMN IF NOT EXISTS "referral_code" varchar(20) DEFAULT NULL;
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_users_referral_code_active ON "user"."users" ("referral_code") WHERE "deleted_on" IS NULL;
+
+  DROP TYPE IF EXISTS "user".referrals_status_enum;
+  CREATE TYPE "user".referrals_status_enum AS ENUM ('pending','rewarded');
+
+  CREATE TABLE IF NOT EXISTS "user"."referrals" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "referrer_id" int NOT NULL,
+    "referee_id" int NOT NULL,
+    "referral_code" varchar(20) NOT NULL,
+    "status" "user"."referrals_status_enum" NOT NULL DEFAULT 'pending',
+    "reward_amount" decimal(18,2) DEFAULT NULL,
+    "rewarded_booking_id" int DEFAULT NULL,
+    "rewarded_on" timestamptz DEFAULT NULL,
+    "wallet_transaction_id" int DEFAULT NULL,
+    CONSTRAINT fk_referrals_referrer_id FOREIGN KEY ("referrer_id") REFERENCES "user"."users" ("id"),
+    CONSTRAINT fk_referrals_referee_id FOREIGN KEY ("referee_id") REFERENCES "user"."users" ("id"),
+    CONSTRAINT fk_referrals_rewarded_booking_id FOREIGN KEY ("rewarded_booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT fk_referrals_wallet_transaction_id FOREIGN KEY ("wallet_transaction_id") REFERENCES "transaction"."wallet_transactions" ("id"),
+    CONSTRAINT ck_referrals_not_self CHECK ("referrer_id" <> "referee_id")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_referrals_uid_active ON "user"."referrals" ("uid") WHERE "deleted_on" IS NULL;
+  -- A user can only be referred once
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_referrals_referee_id_active ON "user"."referrals" ("referee_id") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_referrals_referrer_id ON "user"."referrals" ("referrer_id");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upReferrals: %w", err)
+	}
+	return nil
+}
+
+func downReferrals(ctx context.Context, tx *sql.Tx) error {
+	// Postgres cannot drop a single enum value, so 'referral_reward' stays on the wallet transaction type
+	query := `
+  DROP TABLE IF EXISTS "user"."referrals" CASCADE;
+  DROP TYPE IF EXISTS "user".referrals_status_enum CASCADE;
+  DROP INDEX IF EXISTS "user".ux_users_referral_code_active;
+  ALTER TABLE "user"."users" DROP COLUMN IF EXISTS "referral_code";
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downReferrals: %w", err)
+	}
+	return nil
+}
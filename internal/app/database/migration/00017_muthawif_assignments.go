@@ -0,0 +1,61 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upMuthawifAssignments, downMuthawifAssignments)
+}
+
+func upMuthawifAssignments(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  -- Needed to combine the equality on muthawif_id with the date range overlap in one exclusion constraint
+  CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+  CREATE TABLE IF NOT EXISTS "core"."muthawif_assignments" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "departure_batch_id" int NOT NULL,
+    "muthawif_id" int NOT NULL,
+    -- Copied from the departure batch so overlaps can be enforced by the exclusion constraint below
+    "departure_date" date NOT NULL,
+    "return_date" date NOT NULL,
+    "notes" text DEFAULT NULL,
+    CONSTRAINT fk_muthawif_assignments_departure_batch_id FOREIGN KEY ("departure_batch_id") REFERENCES "core"."departure_batches" ("id"),
+    CONSTRAINT fk_muthawif_assignments_muthawif_id FOREIGN KEY ("muthawif_id") REFERENCES "user"."users" ("id"),
+    CONSTRAINT ck_muthawif_assignments_dates CHECK ("return_date" >= "departure_date"),
+    -- A guide cannot be on two trips whose dates overlap, including trips that share a departure or return day
+    CONSTRAINT ex_muthawif_assignments_overlap EXCLUDE USING gist (
+      "muthawif_id" WITH =,
+      daterange("departure_date", "return_date", '[]') WITH &&
+    ) WHERE ("deleted_on" IS NULL)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_muthawif_assignments_uid_active ON "core"."muthawif_assignments" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_muthawif_assignments_departure_batch_id ON "core"."muthawif_assignments" ("departure_batch_id");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upMuthawifAssignments: %w", err)
+	}
+	return nil
+}
+
+func downMuthawifAssignments(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TABLE IF EXISTS "core"."muthawif_assignments" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downMuthawifAssignments: %w", err)
+	}
+	return nil
+}
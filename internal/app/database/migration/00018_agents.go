@@ -0,0 +1,112 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAgents, downAgents)
+}
+
+func upAgents(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  -- Back-office accounts: travel agents, staff and financing institution users
+  CREATE TABLE IF NOT EXISTS "user"."admins" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "full_name" varchar(255) NOT NULL,
+    "email" varchar(320) NOT NULL,
+    "phone" varchar(50) DEFAULT NULL,
+    "password_hash" varchar(255) DEFAULT NULL,
+    "is_active" boolean NOT NULL DEFAULT true,
+    "role" "user"."admins_role_enum" NOT NULL,
+    "role_level" "user"."admin_role_level_enum" DEFAULT NULL
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_admins_uid_active ON "user"."admins" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_admins_email_active ON "user"."admins" (lower("email")) WHERE "deleted_on" IS NULL;
+
+  ALTER TABLE "transaction"."bookings"
+    ADD COLUMN IF NOT EXISTS "agent_id" int DEFAULT NULL,
+    ADD CONSTRAINT fk_bookings_agent_id FOREIGN KEY ("agent_id") REFERENCES "user"."admins" ("id");
+
+  CREATE INDEX IF NOT EXISTS ix_bookings_agent_id ON "transaction"."bookings" ("agent_id");
+
+  -- A rate applies to an agent, a product or an agent on a product; the most specific one wins
+  CREATE TABLE IF NOT EXISTS "core"."commission_rates" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "agent_id" int DEFAULT NULL,
+    "product_id" int DEFAULT NULL,
+    "percent" decimal(5,2) NOT NULL,
+    CONSTRAINT fk_commission_rates_agent_id FOREIGN KEY ("agent_id") REFERENCES "user"."admins" ("id"),
+    CONSTRAINT fk_commission_rates_product_id FOREIGN KEY ("product_id") REFERENCES "core"."products" ("id"),
+    CONSTRAINT ck_commission_rates_scope CHECK ("agent_id" IS NOT NULL OR "product_id" IS NOT NULL),
+    CONSTRAINT ck_commission_rates_percent CHECK ("percent" > 0 AND "percent" <= 100)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_commission_rates_uid_active ON "core"."commission_rates" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_commission_rates_scope_active ON "core"."commission_rates" (COALESCE("agent_id", 0), COALESCE("product_id", 0)) WHERE "deleted_on" IS NULL;
+
+  CREATE TABLE IF NOT EXISTS "transaction"."agent_commissions" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "agent_id" int NOT NULL,
+    "booking_id" int NOT NULL,
+    "booking_code" varchar(100) NOT NULL,
+    "commission_rate_id" int NOT NULL,
+    "booking_amount" decimal(18,2) NOT NULL,
+    "percent" decimal(5,2) NOT NULL,
+    "amount" decimal(18,2) NOT NULL,
+    "period" varchar(7) NOT NULL,
+    "earned_on" timestamptz NOT NULL,
+    CONSTRAINT fk_agent_commissions_agent_id FOREIGN KEY ("agent_id") REFERENCES "user"."admins" ("id"),
+    CONSTRAINT fk_agent_commissions_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    CONSTRAINT fk_agent_commissions_commission_rate_id FOREIGN KEY ("commission_rate_id") REFERENCES "core"."commission_rates" ("id"),
+    CONSTRAINT ck_agent_commissions_amount CHECK ("amount" >= 0)
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_agent_commissions_uid_active ON "transaction"."agent_commissions" ("uid") WHERE "deleted_on" IS NULL;
+  -- A booking earns its agent a commission only once
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_agent_commissions_booking_id_active ON "transaction"."agent_commissions" ("booking_id") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_agent_commissions_agent_period ON "transaction"."agent_commissions" ("agent_id", "period");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upAgents: %w", err)
+	}
+	return nil
+}
+
+func downAgents(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TABLE IF EXISTS "transaction"."agent_commissions" CASCADE;
+  DROP TABLE IF EXISTS "core"."commission_rates" CASCADE;
+  ALTER TABLE "transaction"."bookings"
+    DROP CONSTRAINT IF EXISTS fk_bookings_agent_id,
+    DROP COLUMN IF EXISTS "agent_id";
+  DROP TABLE IF EXISTS "user"."admins" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downAgents: %w", err)
+	}
+	return nil
+}
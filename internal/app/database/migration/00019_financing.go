@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upFinancing, downFinancing)
+}
+
+func upFinancing(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  ALTER TYPE "transaction".bookings_installment_request_status_enum ADD VALUE IF NOT EXISTS 'rejected';
+
+  ALTER TABLE "transaction"."installment_plans"
+    ADD COLUMN IF NOT EXISTS "financing_institution_id" int DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "decision_notes" text DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "rejected_by" jsonb DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "rejected_on" timestamptz DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "disbursement_payment_id" int DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "disbursed_on" timestamptz DEFAULT NULL,
+    ADD CONSTRAINT fk_installment_plans_financing_institution_id FOREIGN KEY ("financing_institution_id") REFERENCES "user"."admins" ("id"),
+    ADD CONSTRAINT fk_installment_plans_disbursement_payment_id FOREIGN KEY ("disbursement_payment_id") REFERENCES "transaction"."payments" ("id");
+
+  CREATE INDEX IF NOT EXISTS ix_installment_plans_financing_institution_id ON "transaction"."installment_plans" ("financing_institution_id") WHERE "deleted_on" IS NULL AND "financing_institution_id" IS NOT NULL;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upFinancing: %w", err)
+	}
+	return nil
+}
+
+func downFinancing(ctx context.Context, tx *sql.Tx) error {
+	// Postgres cannot drop a single enum value, so 'rejected' stays on the installment request status type
+	query := `
+  DROP INDEX IF EXISTS "transaction".ix_installment_plans_financing_institution_id;
+  ALTER TABLE "transaction"."installment_plans"
+    DROP CONSTRAINT IF EXISTS fk_installment_plans_disbursement_payment_id,
+    DROP CONSTRAINT IF EXISTS fk_installment_plans_financing_institution_id,
+    DROP COLUMN IF EXISTS "disbursed_on",
+    DROP COLUMN IF EXISTS "disbursement_payment_id",
+    DROP COLUMN IF EXISTS "rejected_on",
+    DROP COLUMN IF EXISTS "rejected_by",
+    DROP COLUMN IF EXISTS "decision_notes",
+    DROP COLUMN IF EXISTS "financing_institution_id";
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downFinancing: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upUsersUniqueContacts, downUsersUniqueContacts)
+}
+
+func upUsersUniqueContacts(ctx context.Context, tx *sql.Tx) error {
+	// Emails are stored lowercased by the application, so a plain index is enough to keep them unique
+	query := `
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_users_email_active ON "user"."users" ("email") WHERE "deleted_on" IS NULL AND "email" IS NOT NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_users_phone_active ON "user"."users" ("phone") WHERE "deleted_on" IS NULL;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upUsersUniqueContacts: %w", err)
+	}
+	return nil
+}
+
+func downUsersUniqueContacts(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP INDEX IF EXISTS "user".ux_users_phone_active;
+  DROP INDEX IF EXISTS "user".ux_users_email_active;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downUsersUniqueContacts: %w", err)
+	}
+	return nil
+}
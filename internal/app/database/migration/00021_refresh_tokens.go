@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upRefreshTokens, downRefreshTokens)
+}
+
+func upRefreshTokens(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "user"."refresh_tokens" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "principal_id" int NOT NULL,
+    "principal_role" varchar(20) NOT NULL,
+    "family_id" uuid NOT NULL,
+    "token_hash" char(64) NOT NULL,
+    "expires_on" timestamptz NOT NULL,
+    "used_on" timestamptz DEFAULT NULL,
+    "replaced_by_id" int DEFAULT NULL,
+    "revoked_on" timestamptz DEFAULT NULL,
+    CONSTRAINT fk_refresh_tokens_replaced_by_id FOREIGN KEY ("replaced_by_id") REFERENCES "user"."refresh_tokens" ("id")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_refresh_tokens_uid_active ON "user"."refresh_tokens" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_refresh_tokens_token_hash ON "user"."refresh_tokens" ("token_hash");
+  CREATE INDEX IF NOT EXISTS ix_refresh_tokens_family_id ON "user"."refresh_tokens" ("family_id");
+  CREATE INDEX IF NOT EXISTS ix_refresh_tokens_principal_unrevoked ON "user"."refresh_tokens" ("principal_id", "principal_role") WHERE "revoked_on" IS NULL;
+
+  CREATE TABLE IF NOT EXISTS "user"."access_token_denylist" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "session_id" uuid NOT NULL,
+    "expires_on" timestamptz NOT NULL
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_access_token_denylist_uid_active ON "user"."access_token_denylist" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_access_token_denylist_session_id ON "user"."access_token_denylist" ("session_id");
+  CREATE INDEX IF NOT EXISTS ix_access_token_denylist_expires_on ON "user"."access_token_denylist" ("expires_on");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upRefreshTokens: %w", err)
+	}
+	return nil
+}
+
+func downRefreshTokens(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TABLE IF EXISTS "user"."access_token_denylist" CASCADE;
+  DROP TABLE IF EXISTS "user"."refresh_tokens" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downRefreshTokens: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upEmailVerifications, downEmailVerifications)
+}
+
+func upEmailVerifications(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "user"."email_verification_sends" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "user_id" int NOT NULL,
+    "email" varchar(320) NOT NULL,
+    "expires_on" timestamptz NOT NULL,
+    CONSTRAINT fk_email_verification_sends_user_id FOREIGN KEY ("user_id") REFERENCES "user"."users" ("id")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_email_verification_sends_uid_active ON "user"."email_verification_sends" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE INDEX IF NOT EXISTS ix_email_verification_sends_user_id_created_on ON "user"."email_verification_sends" ("user_id", "created_on");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upEmailVerifications: %w", err)
+	}
+	return nil
+}
+
+func downEmailVerifications(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TABLE IF EXISTS "user"."email_verification_sends" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downEmailVerifications: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upPasswordResetTokens, downPasswordResetTokens)
+}
+
+func upPasswordResetTokens(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  CREATE TABLE IF NOT EXISTS "user"."password_reset_tokens" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "user_id" int NOT NULL,
+    "token_hash" char(64) NOT NULL,
+    "expires_on" timestamptz NOT NULL,
+    "used_on" timestamptz DEFAULT NULL,
+    CONSTRAINT fk_password_reset_tokens_user_id FOREIGN KEY ("user_id") REFERENCES "user"."users" ("id")
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_password_reset_tokens_uid_active ON "user"."password_reset_tokens" ("uid") WHERE "deleted_on" IS NULL;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_password_reset_tokens_token_hash ON "user"."password_reset_tokens" ("token_hash");
+  CREATE INDEX IF NOT EXISTS ix_password_reset_tokens_user_id_unused ON "user"."password_reset_tokens" ("user_id") WHERE "used_on" IS NULL;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upPasswordResetTokens: %w", err)
+	}
+	return nil
+}
+
+func downPasswordResetTokens(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP TABLE IF EXISTS "user"."password_reset_tokens" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downPasswordResetTokens: %w", err)
+	}
+	return nil
+}
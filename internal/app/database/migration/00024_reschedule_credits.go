@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upRescheduleCredits, downRescheduleCredits)
+}
+
+func upRescheduleCredits(ctx context.Context, tx *sql.Tx) error {
+	// A reschedule to a cheaper departure moves the surplus paid out of the booking, which is recorded
+	// as a negative payment so the booking's total payment keeps matching its ledger
+	query := `
+  ALTER TABLE "transaction"."payments" DROP CONSTRAINT IF EXISTS ck_payments_amount_positive;
+  ALTER TABLE "transaction"."payments" ADD CONSTRAINT ck_payments_amount_sign CHECK (
+    CASE WHEN "method" = 'reschedule_credit' THEN "amount" < 0 ELSE "amount" > 0 END
+  );
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upRescheduleCredits: %w", err)
+	}
+	return nil
+}
+
+func downRescheduleCredits(ctx context.Context, tx *sql.Tx) error {
+	// Fails while reschedule credits exist, as they cannot be represented without negative payments
+	query := `
+  ALTER TABLE "transaction"."payments" DROP CONSTRAINT IF EXISTS ck_payments_amount_sign;
+  ALTER TABLE "transaction"."payments" ADD CONSTRAINT ck_payments_amount_positive CHECK ("amount" > 0);
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downRescheduleCredits: %w", err)
+	}
+	return nil
+}
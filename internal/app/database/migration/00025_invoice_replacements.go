@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInvoiceReplacements, downInvoiceReplacements)
+}
+
+func upInvoiceReplacements(ctx context.Context, tx *sql.Tx) error {
+	// An invoice is voided and replaced when its booking's amounts change, so a booking keeps a
+	// single current invoice next to the voided ones it replaced
+	query := `
+  ALTER TABLE "transaction"."invoices"
+    ADD COLUMN IF NOT EXISTS "replaces_invoice_id" int DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "voided_on" timestamptz DEFAULT NULL,
+    ADD CONSTRAINT fk_invoices_replaces_invoice_id FOREIGN KEY ("replaces_invoice_id") REFERENCES "transaction"."invoices" ("id");
+
+  DROP INDEX IF EXISTS "transaction".ux_invoices_booking_id_active;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_invoices_booking_id_current ON "transaction"."invoices" ("booking_id") WHERE "deleted_on" IS NULL AND "voided_on" IS NULL;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upInvoiceReplacements: %w", err)
+	}
+	return nil
+}
+
+func downInvoiceReplacements(ctx context.Context, tx *sql.Tx) error {
+	// Fails while bookings have replaced invoices, as only one invoice per booking can be kept
+	query := `
+  DROP INDEX IF EXISTS "transaction".ux_invoices_booking_id_current;
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_invoices_booking_id_active ON "transaction"."invoices" ("booking_id") WHERE "deleted_on" IS NULL;
+
+  ALTER TABLE "transaction"."invoices"
+    DROP CONSTRAINT IF EXISTS fk_invoices_replaces_invoice_id,
+    DROP COLUMN IF EXISTS "voided_on",
+    DROP COLUMN IF EXISTS "replaces_invoice_id";
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downInvoiceReplacements: %w", err)
+	}
+	return nil
+}
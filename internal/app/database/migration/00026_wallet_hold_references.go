@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upWalletHoldReferences, downWalletHoldReferences)
+}
+
+func upWalletHoldReferences(ctx context.Context, tx *sql.Tx) error {
+	// A hold reserves funds for a booking, optionally for one of its payments. Holds placed
+	// before holds were tied to a booking keep no reference
+	query := `
+  ALTER TABLE "transaction"."wallet_holds"
+    ADD COLUMN IF NOT EXISTS "booking_id" int DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS "payment_id" int DEFAULT NULL,
+    ADD CONSTRAINT fk_wallet_holds_booking_id FOREIGN KEY ("booking_id") REFERENCES "transaction"."bookings" ("id"),
+    ADD CONSTRAINT fk_wallet_holds_payment_id FOREIGN KEY ("payment_id") REFERENCES "transaction"."payments" ("id");
+
+  CREATE INDEX IF NOT EXISTS ix_wallet_holds_booking_id ON "transaction"."wallet_holds" ("booking_id");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upWalletHoldReferences: %w", err)
+	}
+	return nil
+}
+
+func downWalletHoldReferences(ctx context.Context, tx *sql.Tx) error {
+	query := `
+  DROP INDEX IF EXISTS "transaction".ix_wallet_holds_booking_id;
+
+  ALTER TABLE "transaction"."wallet_holds"
+    DROP CONSTRAINT IF EXISTS fk_wallet_holds_payment_id,
+    DROP CONSTRAINT IF EXISTS fk_wallet_holds_booking_id,
+    DROP COLUMN IF EXISTS "payment_id",
+    DROP COLUMN IF EXISTS "booking_id";
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downWalletHoldReferences: %w", err)
+	}
+	return nil
+}
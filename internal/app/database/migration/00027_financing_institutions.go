@@ -0,0 +1,91 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upFinancingInstitutions, downFinancingInstitutions)
+}
+
+func upFinancingInstitutions(ctx context.Context, tx *sql.Tx) error {
+	// Financing requests were routed to a single fin_inst admin. They are now routed to an institution
+	// whose fin_inst admins share its requests: every existing fin_inst admin becomes an institution
+	// of its own, and the requests routed to the admin move to that institution
+	query := `
+  CREATE TABLE IF NOT EXISTS "user"."financing_institutions" (
+    "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    "uid" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "created_on" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" jsonb NOT NULL DEFAULT ('{"user_uid": "SYSTEM", "user_name": "SYSTEM"}')::jsonb,
+    "modified_on" timestamptz DEFAULT NULL,
+    "modified_by" jsonb DEFAULT NULL,
+    "deleted_on" timestamptz DEFAULT NULL,
+    "name" varchar(255) NOT NULL,
+    "is_active" boolean NOT NULL DEFAULT true
+  );
+
+  CREATE UNIQUE INDEX IF NOT EXISTS ux_financing_institutions_uid_active ON "user"."financing_institutions" ("uid") WHERE "deleted_on" IS NULL;
+
+  ALTER TABLE "user"."admins"
+    ADD COLUMN IF NOT EXISTS "financing_institution_id" int DEFAULT NULL,
+    ADD CONSTRAINT fk_admins_financing_institution_id FOREIGN KEY ("financing_institution_id") REFERENCES "user"."financing_institutions" ("id");
+
+  ALTER TABLE "transaction"."installment_plans"
+    DROP CONSTRAINT IF EXISTS fk_installment_plans_financing_institution_id;
+
+  DO $$
+  DECLARE
+    a record;
+    institution_id int;
+  BEGIN
+    FOR a IN SELECT "id", "full_name" FROM "user"."admins" WHERE "role" = 'fin_inst' ORDER BY "id" LOOP
+      INSERT INTO "user"."financing_institutions" ("name") VALUES (a."full_name") RETURNING "id" INTO institution_id;
+      UPDATE "user"."admins" SET "financing_institution_id" = institution_id WHERE "id" = a."id";
+      UPDATE "transaction"."installment_plans" SET "financing_institution_id" = institution_id WHERE "financing_institution_id" = a."id";
+    END LOOP;
+  END $$;
+
+  ALTER TABLE "user"."admins"
+    ADD CONSTRAINT ck_admins_financing_institution_id CHECK (("role" = 'fin_inst') = ("financing_institution_id" IS NOT NULL));
+
+  ALTER TABLE "transaction"."installment_plans"
+    ADD CONSTRAINT fk_installment_plans_financing_institution_id FOREIGN KEY ("financing_institution_id") REFERENCES "user"."financing_institutions" ("id");
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute upFinancingInstitutions: %w", err)
+	}
+	return nil
+}
+
+func downFinancingInstitutions(ctx context.Context, tx *sql.Tx) error {
+	// Requests move back to the first fin_inst admin of their institution
+	query := `
+  ALTER TABLE "transaction"."installment_plans"
+    DROP CONSTRAINT IF EXISTS fk_installment_plans_financing_institution_id;
+
+  UPDATE "transaction"."installment_plans" p
+  SET "financing_institution_id" = (
+    SELECT MIN(a."id") FROM "user"."admins" a WHERE a."financing_institution_id" = p."financing_institution_id"
+  )
+  WHERE p."financing_institution_id" IS NOT NULL;
+
+  ALTER TABLE "transaction"."installment_plans"
+    ADD CONSTRAINT fk_installment_plans_financing_institution_id FOREIGN KEY ("financing_institution_id") REFERENCES "user"."admins" ("id");
+
+  ALTER TABLE "user"."admins"
+    DROP CONSTRAINT IF EXISTS ck_admins_financing_institution_id,
+    DROP CONSTRAINT IF EXISTS fk_admins_financing_institution_id,
+    DROP COLUMN IF EXISTS "financing_institution_id";
+
+  DROP TABLE IF EXISTS "user"."financing_institutions" CASCADE;
+`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to execute downFinancingInstitutions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+)
+
+// BootstrapOption holds the account details of the first super admin.
+type BootstrapOption struct {
+	FullName string
+	Email    string
+	Password string
+}
+
+// EnsureSuperAdmin creates a super admin account from the given details unless an active super admin exists,
+// since admin accounts can only be managed by super admins. It reports whether an account was created.
+func EnsureSuperAdmin(ctx context.Context, uow contract.UnitOfWork, hasher contract.PasswordHasher, opt BootstrapOption) (bool, error) {
+	ctx, span := serviceTracer.Start(ctx, "EnsureSuperAdmin")
+	defer span.End()
+
+	var created bool
+	err := uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		superAdmins, err := uow.AdminRepository().FindSuperAdminsForUpdate(ctx)
+		if err != nil || len(superAdmins) > 0 {
+			return err
+		}
+
+		hash, err := hasher.Hash(opt.Password)
+		if err != nil {
+			return err
+		}
+
+		level := model.AdminRoleLevelSuperAdmin
+		_, err = uow.AdminRepository().Save(ctx, &model.Admin{
+			FullName:     strings.TrimSpace(opt.FullName),
+			Email:        strings.ToLower(strings.TrimSpace(opt.Email)),
+			PasswordHash: &hash,
+			Role:         model.AdminRoleAdmin,
+			RoleLevel:    &level,
+			CreatedBy:    actor.System().JSON(),
+		})
+		if err != nil {
+			if dberror.GetSQLState(err) == dberror.UniqueViolation {
+				return ErrEmailExists(err)
+			}
+
+			return err
+		}
+
+		created = true
+		return nil
+	})
+
+	return created, err
+}
@@ -0,0 +1,68 @@
+package admin
+
+import "github.com/aburizalpurnama/travel/internal/pkg/apperror"
+
+// ==========================================================
+// Admin Error Constructors
+// ==========================================================
+
+// ErrAdminNotFound creates a new error for missing admin records.
+func ErrAdminNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"admin not found",
+		err,
+		nil,
+	)
+}
+
+// ErrEmailExists creates a new error for an email already used by another admin account.
+func ErrEmailExists(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.EmailExists,
+		"email is already registered",
+		err,
+		map[string]any{"email": apperror.InvalidValue},
+	)
+}
+
+// ErrInvalidRoleLevel creates a new error for a role level given to a role other than 'admin'.
+func ErrInvalidRoleLevel() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"only admins with the 'admin' role can have a role level",
+		nil,
+		map[string]any{"role_level": apperror.InvalidChoice},
+	)
+}
+
+// ErrFinancingInstitutionRequired creates a new error for a 'fin_inst' admin without a financing institution.
+func ErrFinancingInstitutionRequired() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"admins with the 'fin_inst' role must belong to a financing institution",
+		nil,
+		map[string]any{"financing_institution_id": apperror.IsRequired},
+	)
+}
+
+// ErrInvalidFinancingInstitution creates a new error for a financing institution given to a role other than
+// 'fin_inst', or one that does not exist or is not active.
+func ErrInvalidFinancingInstitution(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"only admins with the 'fin_inst' role can belong to a financing institution, which must exist and be active",
+		err,
+		map[string]any{"financing_institution_id": apperror.InvalidValue},
+	)
+}
+
+// ErrLastSuperAdmin creates a new error for disabling or demoting the last remaining super admin.
+func ErrLastSuperAdmin() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"the last remaining super admin cannot be disabled or demoted",
+		nil,
+		nil,
+	)
+}
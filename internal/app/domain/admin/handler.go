@@ -0,0 +1,168 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("admin.handler")
+
+type Handler struct {
+	service contract.AdminService
+}
+
+// NewHandler initializes a new instance of AdminHandler.
+func NewHandler(service contract.AdminService) *Handler {
+	return &Handler{service: service}
+}
+
+// CreateAdmin handles the creation of a new admin account.
+func (h *Handler) CreateAdmin(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "CreateAdmin")
+	defer span.End()
+
+	var req payload.AdminCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	admin, err := h.service.CreateAdmin(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(admin, nil))
+}
+
+// GetAdmins retrieves a list of admin accounts with pagination and filtering.
+func (h *Handler) GetAdmins(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAdmins")
+	defer span.End()
+
+	req := payload.AdminGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	admins, pagination, err := h.service.GetAllAdmins(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(admins, pagination))
+}
+
+// GetAdmin retrieves a single admin account by its ID.
+func (h *Handler) GetAdmin(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAdmin")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	admin, err := h.service.GetAdminByID(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(admin, nil))
+}
+
+// UpdateAdmin handles updating, disabling or re-roling an admin account.
+func (h *Handler) UpdateAdmin(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "UpdateAdmin")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.AdminUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	admin, err := h.service.UpdateAdmin(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(admin, nil))
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("admin.repository")
+
+// Repository implements the contract.AdminRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Admin, model.AdminFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new admin repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Admin, model.AdminFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.AdminRepository = (*Repository)(nil)
+
+// FindByEmail retrieves the admin with the given email address, compared case-insensitively.
+func (r *Repository) FindByEmail(ctx context.Context, email string) (*model.Admin, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByEmail")
+	defer span.End()
+
+	var data model.Admin
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND LOWER(email) = LOWER(?)", email).
+		First(&data).Error
+	return &data, err
+}
+
+// FindSuperAdminsForUpdate retrieves the active super admins and locks their rows until the transaction ends,
+// so concurrent demotions cannot remove the last one.
+func (r *Repository) FindSuperAdminsForUpdate(ctx context.Context) ([]model.Admin, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindSuperAdminsForUpdate")
+	defer span.End()
+
+	var data []model.Admin
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_on IS NULL AND is_active AND role = ? AND role_level = ?", model.AdminRoleAdmin, model.AdminRoleLevelSuperAdmin).
+		Order("id").
+		Find(&data).Error
+	return data, err
+}
@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers admin account management routes to the provided router group.
+// Every route requires the admin:manage permission, granted to super admins.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	admins := router.Group("/admins", authz.Require(rbac.AdminManage))
+
+	admins.Post("/", handler.CreateAdmin)
+	admins.Get("/", handler.GetAdmins)
+	admins.Get("/:id", handler.GetAdmin)
+	admins.Patch("/:id", handler.UpdateAdmin)
+}
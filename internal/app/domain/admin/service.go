@@ -0,0 +1,297 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("admin.service")
+
+// SessionEndFunc ends every login session of a principal, so its access and refresh tokens stop working.
+// It is called within the update transaction when an admin is disabled or its role changes.
+type SessionEndFunc func(ctx context.Context, uow contract.UnitOfWork, principalID uint, role string) error
+
+type service struct {
+	uow         contract.UnitOfWork
+	mapper      contract.Mapper
+	hasher      contract.PasswordHasher
+	endSessions SessionEndFunc
+}
+
+// NewService initializes a new instance of admin service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, hasher contract.PasswordHasher, endSessions SessionEndFunc) *service {
+	return &service{uow: uow, mapper: mapper, hasher: hasher, endSessions: endSessions}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.AdminService = (*service)(nil)
+
+// CreateAdmin creates a new admin account. Emails are unique across all admin accounts
+// and the password is stored as a one-way hash.
+func (s *service) CreateAdmin(ctx context.Context, req payload.AdminCreateRequest) (*payload.AdminResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CreateAdmin")
+	defer span.End()
+
+	level, err := resolveRoleLevel(req.Role, req.RoleLevel, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	institutionID, err := resolveInstitution(ctx, s.uow, req.Role, req.FinancingInstitutionID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := s.hasher.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.uow.AdminRepository().Save(ctx, &model.Admin{
+		FullName:               strings.TrimSpace(req.FullName),
+		Email:                  strings.ToLower(strings.TrimSpace(req.Email)),
+		Phone:                  req.Phone,
+		PasswordHash:           &hash,
+		Role:                   req.Role,
+		RoleLevel:              level,
+		FinancingInstitutionID: institutionID,
+		CreatedBy:              actor.FromContext(ctx).JSON(),
+	})
+	if err != nil {
+		if dberror.GetSQLState(err) == dberror.UniqueViolation {
+			return nil, ErrEmailExists(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toAdminResponse(created)
+}
+
+// GetAllAdmins retrieves a list of admin accounts with support for pagination and filtering.
+func (s *service) GetAllAdmins(ctx context.Context, req payload.AdminGetAllRequest) ([]payload.AdminResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllAdmins")
+	defer span.End()
+
+	if req.AdminFilter == nil {
+		req.AdminFilter = &model.AdminFilter{}
+	}
+
+	var count int64
+	var admins []model.Admin
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.AdminRepository().Count(groupCtx, req.AdminFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		admins, err = s.uow.AdminRepository().FindAll(groupCtx, req.Page, req.Size, req.AdminFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp []payload.AdminResponse
+	err = s.mapper.ToResponse(admins, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetAdminByID retrieves a specific admin account by its unique identifier.
+func (s *service) GetAdminByID(ctx context.Context, id uint) (*payload.AdminResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAdminByID")
+	defer span.End()
+
+	a, err := s.uow.AdminRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAdminNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toAdminResponse(a)
+}
+
+// UpdateAdmin modifies an existing admin account, including disabling it and changing its role or role level.
+// The last remaining super admin cannot be disabled or demoted. Disabling or re-roling an admin ends
+// its sessions, since its access tokens carry the previous role.
+func (s *service) UpdateAdmin(ctx context.Context, id uint, req payload.AdminUpdateRequest) (*payload.AdminResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "UpdateAdmin")
+	defer span.End()
+
+	var updated *model.Admin
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		// Lock the super admins before reading the admin, so concurrent demotions are counted one after another
+		var superAdmins []model.Admin
+		if req.IsActive != nil || req.Role != nil || req.RoleLevel != nil {
+			var err error
+			superAdmins, err = uow.AdminRepository().FindSuperAdminsForUpdate(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		a, err := uow.AdminRepository().FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAdminNotFound(err)
+			}
+
+			return err
+		}
+
+		wasSuperAdmin := a.IsSuperAdmin()
+		wasActive := a.IsActive == nil || *a.IsActive
+		prevRole, prevLevel := a.Role, a.RoleLevel
+
+		if req.FullName != nil {
+			a.FullName = strings.TrimSpace(*req.FullName)
+		}
+		if req.Phone != nil {
+			a.Phone = req.Phone
+		}
+		if req.IsActive != nil {
+			a.IsActive = req.IsActive
+		}
+		if req.Role != nil {
+			a.Role = *req.Role
+		}
+
+		a.RoleLevel, err = resolveRoleLevel(a.Role, req.RoleLevel, a.RoleLevel)
+		if err != nil {
+			return err
+		}
+
+		a.FinancingInstitutionID, err = resolveInstitution(ctx, uow, a.Role, req.FinancingInstitutionID, a.FinancingInstitutionID)
+		if err != nil {
+			return err
+		}
+
+		if wasSuperAdmin && !a.IsSuperAdmin() && len(superAdmins) <= 1 {
+			return ErrLastSuperAdmin()
+		}
+
+		now := time.Now()
+		a.ModifiedOn = &now
+		a.ModifiedBy = actor.FromContext(ctx).JSON()
+
+		updated, err = uow.AdminRepository().Update(ctx, a)
+		if err != nil {
+			return err
+		}
+
+		disabled := wasActive && updated.IsActive != nil && !*updated.IsActive
+		reroled := prevRole != updated.Role || !equalLevel(prevLevel, updated.RoleLevel)
+		if (disabled || reroled) && s.endSessions != nil {
+			return s.endSessions(ctx, uow, updated.ID, prevRole)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAdminResponse(updated)
+}
+
+// toAdminResponse maps an admin to the response DTO.
+func (s *service) toAdminResponse(a *model.Admin) (*payload.AdminResponse, error) {
+	var resp payload.AdminResponse
+	err := s.mapper.ToResponse(a, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// resolveRoleLevel returns the role level an admin with the given role ends up with.
+// Only the 'admin' role has a level: the requested one, else the current one, else 'admin'.
+func resolveRoleLevel(role string, requested, current *string) (*string, error) {
+	if role != model.AdminRoleAdmin {
+		if requested != nil {
+			return nil, ErrInvalidRoleLevel()
+		}
+		return nil, nil
+	}
+
+	if requested != nil {
+		return requested, nil
+	}
+	if current != nil {
+		return current, nil
+	}
+
+	level := model.AdminRoleLevelAdmin
+	return &level, nil
+}
+
+// resolveInstitution returns the financing institution an admin with the given role ends up with.
+// Only the 'fin_inst' role has one, which it requires: the requested one, else the current one.
+// A requested institution must exist and be active.
+func resolveInstitution(ctx context.Context, uow contract.UnitOfWork, role string, requested, current *uint) (*uint, error) {
+	if role != model.AdminRoleFinInst {
+		if requested != nil {
+			return nil, ErrInvalidFinancingInstitution(nil)
+		}
+		return nil, nil
+	}
+
+	if requested == nil {
+		if current == nil {
+			return nil, ErrFinancingInstitutionRequired()
+		}
+		return current, nil
+	}
+
+	institution, err := uow.FinancingInstitutionRepository().FindByID(ctx, *requested)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidFinancingInstitution(err)
+		}
+
+		return nil, err
+	}
+
+	if institution.IsActive != nil && !*institution.IsActive {
+		return nil, ErrInvalidFinancingInstitution(nil)
+	}
+
+	return requested, nil
+}
+
+// equalLevel reports whether two optional role levels are the same.
+func equalLevel(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
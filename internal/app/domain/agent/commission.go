@@ -0,0 +1,55 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Earn records the commission of a booking attributed to an agent once the booking is paid in full.
+// The rate in force at that moment is applied to the total amount, after discounts. Bookings without
+// an agent or an applicable rate earn nothing, and a booking earns at most once.
+// The booking must be locked. It must be called within a transaction.
+func Earn(ctx context.Context, uow contract.UnitOfWork, b *model.Booking) error {
+	if b.AgentID == nil || b.PaymentStatus != model.PaymentStatusPaid {
+		return nil
+	}
+
+	_, err := uow.AgentCommissionRepository().FindByBookingID(ctx, b.ID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	rate, err := uow.CommissionRateRepository().FindApplicable(ctx, *b.AgentID, b.ProductID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	now := time.Now()
+	_, err = uow.AgentCommissionRepository().Save(ctx, &model.AgentCommission{
+		AgentID:          *b.AgentID,
+		BookingID:        b.ID,
+		BookingCode:      b.Code,
+		CommissionRateID: rate.ID,
+		BookingAmount:    b.TotalAmount,
+		Percent:          rate.Percent,
+		Amount:           b.TotalAmount.Mul(rate.Percent).Div(decimal.NewFromInt(100)).Round(2),
+		Period:           now.Format("2006-01"),
+		EarnedOn:         now,
+		CreatedBy:        actor.FromContext(ctx).JSON(),
+	})
+	return err
+}
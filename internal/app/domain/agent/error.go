@@ -0,0 +1,81 @@
+package agent
+
+import "github.com/aburizalpurnama/travel/internal/pkg/apperror"
+
+// ==========================================================
+// Agent Error Constructors
+// ==========================================================
+
+// ErrAgentNotFound creates a new error for missing agent records.
+func ErrAgentNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"agent not found",
+		err,
+		nil,
+	)
+}
+
+// ErrEmailExists creates a new error for an email already used by another admin account.
+func ErrEmailExists(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.EmailExists,
+		"email is already registered",
+		err,
+		map[string]any{"email": apperror.InvalidValue},
+	)
+}
+
+// ErrAgentForbidden creates a new error for an agent reading the bookings or earnings of another agent.
+func ErrAgentForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"agents can only view their own bookings and earnings",
+		nil,
+		nil,
+	)
+}
+
+// ==========================================================
+// Commission Rate Error Constructors
+// ==========================================================
+
+// ErrCommissionRateNotFound creates a new error for missing commission rate records.
+func ErrCommissionRateNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"commission rate not found",
+		err,
+		nil,
+	)
+}
+
+// ErrInvalidPercent creates a new error for commission rates outside the (0, 100] range.
+func ErrInvalidPercent(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		err,
+		map[string]any{"percent": apperror.InvalidValue},
+	)
+}
+
+// ErrRateScopeRequired creates a new error for commission rates bound to neither an agent nor a product.
+func ErrRateScopeRequired() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"a commission rate needs an agent, a product or both",
+		nil,
+		map[string]any{"agent_id": apperror.IsRequired, "product_id": apperror.IsRequired},
+	)
+}
+
+// ErrCommissionRateExists creates a new error for a second rate with the same agent and product.
+func ErrCommissionRateExists(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.DuplicateEntry,
+		"a commission rate for this agent and product already exists",
+		err,
+		nil,
+	)
+}
@@ -0,0 +1,420 @@
+package agent
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("agent.handler")
+
+type Handler struct {
+	service contract.AgentService
+}
+
+// NewHandler initializes a new instance of AgentHandler.
+func NewHandler(service contract.AgentService) *Handler {
+	return &Handler{service: service}
+}
+
+// CreateAgent handles the creation of a travel agent account.
+func (h *Handler) CreateAgent(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "CreateAgent")
+	defer span.End()
+
+	var req payload.AgentCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	agent, err := h.service.CreateAgent(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(agent, nil))
+}
+
+// GetAllAgents retrieves a list of travel agents with pagination and filtering.
+func (h *Handler) GetAllAgents(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAllAgents")
+	defer span.End()
+
+	req := payload.AgentGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	agents, pagination, err := h.service.GetAllAgents(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(agents, pagination))
+}
+
+// GetAgent retrieves a single travel agent by its ID.
+func (h *Handler) GetAgent(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAgent")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	agent, err := h.service.GetAgentByID(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(agent, nil))
+}
+
+// UpdateAgent handles the update of an existing travel agent.
+func (h *Handler) UpdateAgent(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "UpdateAgent")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.AgentUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	agent, err := h.service.UpdateAgent(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(agent, nil))
+}
+
+// GetAgentBookings retrieves the bookings attributed to an agent with pagination and filtering.
+func (h *Handler) GetAgentBookings(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAgentBookings")
+	defer span.End()
+
+	agentID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	req := payload.BookingGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	bookings, pagination, err := h.service.GetAgentBookings(ctx, uint(agentID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(bookings, pagination))
+}
+
+// GetAgentCommissions retrieves the commissions earned by an agent with pagination and filtering.
+func (h *Handler) GetAgentCommissions(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAgentCommissions")
+	defer span.End()
+
+	agentID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	req := payload.AgentCommissionGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	commissions, pagination, err := h.service.GetAgentCommissions(ctx, uint(agentID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(commissions, pagination))
+}
+
+// GetAgentCommissionStatements retrieves the commission totals of an agent per period.
+func (h *Handler) GetAgentCommissionStatements(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAgentCommissionStatements")
+	defer span.End()
+
+	agentID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	statements, err := h.service.GetAgentCommissionStatements(ctx, uint(agentID))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(statements, nil))
+}
+
+// CreateCommissionRate handles the creation of a commission rate.
+func (h *Handler) CreateCommissionRate(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "CreateCommissionRate")
+	defer span.End()
+
+	var req payload.CommissionRateCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	rate, err := h.service.CreateCommissionRate(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(rate, nil))
+}
+
+// GetAllCommissionRates retrieves a list of commission rates with pagination and filtering.
+func (h *Handler) GetAllCommissionRates(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAllCommissionRates")
+	defer span.End()
+
+	req := payload.CommissionRateGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	rates, pagination, err := h.service.GetAllCommissionRates(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(rates, pagination))
+}
+
+// UpdateCommissionRate handles changing the percentage of a commission rate.
+func (h *Handler) UpdateCommissionRate(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "UpdateCommissionRate")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.CommissionRateUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	rate, err := h.service.UpdateCommissionRate(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(rate, nil))
+}
+
+// DeleteCommissionRate removes a commission rate by its ID.
+func (h *Handler) DeleteCommissionRate(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "DeleteCommissionRate")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	if err := h.service.DeleteCommissionRate(ctx, uint(id)); err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success("success delete data", nil))
+}
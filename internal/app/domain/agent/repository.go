@@ -0,0 +1,93 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("agent.repository")
+
+// CommissionRateRepository implements the contract.CommissionRateRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type CommissionRateRepository struct {
+	*repository.GORM[model.CommissionRate, model.CommissionRateFilter]
+	db *gorm.DB
+}
+
+// NewCommissionRateRepository creates a new commission rate repository instance.
+func NewCommissionRateRepository(db *gorm.DB) *CommissionRateRepository {
+	return &CommissionRateRepository{
+		GORM: repository.NewGORM[model.CommissionRate, model.CommissionRateFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.CommissionRateRepository = (*CommissionRateRepository)(nil)
+
+// FindApplicable retrieves the most specific active rate for an agent selling a product:
+// a rate for the agent on that product first, then the agent's own rate, then the product's rate.
+func (r *CommissionRateRepository) FindApplicable(ctx context.Context, agentID uint, productID *uint) (*model.CommissionRate, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindApplicable")
+	defer span.End()
+
+	var data model.CommissionRate
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL").
+		Where("agent_id = ? OR agent_id IS NULL", agentID).
+		Where("product_id = ? OR product_id IS NULL", productID).
+		Order("agent_id IS NOT NULL DESC, product_id IS NOT NULL DESC").
+		First(&data).Error
+	return &data, err
+}
+
+// CommissionRepository implements the contract.AgentCommissionRepository interface.
+type CommissionRepository struct {
+	*repository.GORM[model.AgentCommission, model.AgentCommissionFilter]
+	db *gorm.DB
+}
+
+// NewCommissionRepository creates a new agent commission repository instance.
+func NewCommissionRepository(db *gorm.DB) *CommissionRepository {
+	return &CommissionRepository{
+		GORM: repository.NewGORM[model.AgentCommission, model.AgentCommissionFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.AgentCommissionRepository = (*CommissionRepository)(nil)
+
+// FindByBookingID retrieves the active commission earned on a booking.
+func (r *CommissionRepository) FindByBookingID(ctx context.Context, bookingID uint) (*model.AgentCommission, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByBookingID")
+	defer span.End()
+
+	var data model.AgentCommission
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND booking_id = ?", bookingID).
+		First(&data).Error
+	return &data, err
+}
+
+// FindStatements totals the active commissions of an agent per period, latest period first.
+func (r *CommissionRepository) FindStatements(ctx context.Context, agentID uint) ([]model.CommissionStatement, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindStatements")
+	defer span.End()
+
+	var data []model.CommissionStatement
+	err := r.db.WithContext(ctx).
+		Model(&model.AgentCommission{}).
+		Select("period, COUNT(*) AS booking_count, SUM(booking_amount) AS booking_amount, SUM(amount) AS amount").
+		Where("deleted_on IS NULL AND agent_id = ?", agentID).
+		Group("period").
+		Order("period DESC").
+		Scan(&data).Error
+	return data, err
+}
@@ -0,0 +1,26 @@
+package agent
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers travel agent and commission routes to the provided router group.
+// Creating, listing and updating agents and managing commission rates requires the agent:manage permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	agents := router.Group("/agents")
+	agents.Post("/", authz.Require(rbac.AgentManage), handler.CreateAgent)
+	agents.Get("/", authz.Require(rbac.AgentManage), handler.GetAllAgents)
+	agents.Get("/:id", handler.GetAgent)
+	agents.Patch("/:id", authz.Require(rbac.AgentManage), handler.UpdateAgent)
+	agents.Get("/:id/bookings", handler.GetAgentBookings)
+	agents.Get("/:id/commissions", handler.GetAgentCommissions)
+	agents.Get("/:id/commission-statements", handler.GetAgentCommissionStatements)
+
+	rates := router.Group("/commission-rates", authz.Require(rbac.AgentManage))
+	rates.Post("/", handler.CreateCommissionRate)
+	rates.Get("/", handler.GetAllCommissionRates)
+	rates.Patch("/:id", handler.UpdateCommissionRate)
+	rates.Delete("/:id", handler.DeleteCommissionRate)
+}
@@ -0,0 +1,517 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/product"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("agent.service")
+
+// SessionEndFunc ends every login session of a principal, so its access and refresh tokens stop working.
+// It is called within the update transaction when an agent is deactivated.
+type SessionEndFunc func(ctx context.Context, uow contract.UnitOfWork, principalID uint, role string) error
+
+type service struct {
+	uow         contract.UnitOfWork
+	mapper      contract.Mapper
+	endSessions SessionEndFunc
+}
+
+// NewService initializes a new instance of agent service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, endSessions SessionEndFunc) *service {
+	return &service{uow: uow, mapper: mapper, endSessions: endSessions}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.AgentService = (*service)(nil)
+
+// CreateAgent creates a new travel agent account. Emails are unique across all admin accounts.
+func (s *service) CreateAgent(ctx context.Context, req payload.AgentCreateRequest) (*payload.AgentResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CreateAgent")
+	defer span.End()
+
+	created, err := s.uow.AdminRepository().Save(ctx, &model.Admin{
+		FullName:  req.FullName,
+		Email:     strings.ToLower(req.Email),
+		Phone:     req.Phone,
+		Role:      model.AdminRoleAgent,
+		CreatedBy: actor.FromContext(ctx).JSON(),
+	})
+	if err != nil {
+		if dberror.GetSQLState(err) == dberror.UniqueViolation {
+			return nil, ErrEmailExists(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toAgentResponse(created)
+}
+
+// GetAllAgents retrieves a list of travel agents with support for pagination and filtering.
+func (s *service) GetAllAgents(ctx context.Context, req payload.AgentGetAllRequest) ([]payload.AgentResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllAgents")
+	defer span.End()
+
+	if req.AdminFilter == nil {
+		req.AdminFilter = &model.AdminFilter{}
+	}
+	role := model.AdminRoleAgent
+	req.Role = &role
+
+	var count int64
+	var agents []model.Admin
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.AdminRepository().Count(groupCtx, req.AdminFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		agents, err = s.uow.AdminRepository().FindAll(groupCtx, req.Page, req.Size, req.AdminFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := []payload.AgentResponse{}
+	err = s.mapper.ToResponse(agents, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetAgentByID retrieves a specific travel agent by its unique identifier. Agents can only view themselves.
+func (s *service) GetAgentByID(ctx context.Context, id uint) (*payload.AgentResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAgentByID")
+	defer span.End()
+
+	err := authorize(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.findAgent(ctx, s.uow, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAgentResponse(a)
+}
+
+// UpdateAgent modifies an existing travel agent. Deactivated agents can no longer be given new bookings
+// and are signed out of every session.
+func (s *service) UpdateAgent(ctx context.Context, id uint, req payload.AgentUpdateRequest) (*payload.AgentResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "UpdateAgent")
+	defer span.End()
+
+	var updated *model.Admin
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		a, err := s.findAgent(ctx, uow, id)
+		if err != nil {
+			return err
+		}
+
+		if req.FullName != nil {
+			a.FullName = *req.FullName
+		}
+		if req.Phone != nil {
+			a.Phone = req.Phone
+		}
+
+		wasActive := a.IsActive == nil || *a.IsActive
+		if req.IsActive != nil {
+			a.IsActive = req.IsActive
+		}
+
+		updated, err = uow.AdminRepository().Update(ctx, a)
+		if err != nil {
+			return err
+		}
+
+		disabled := wasActive && updated.IsActive != nil && !*updated.IsActive
+		if disabled && s.endSessions != nil {
+			return s.endSessions(ctx, uow, updated.ID, updated.Role)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAgentResponse(updated)
+}
+
+// CreateCommissionRate creates a new commission rate for an agent, a product or an agent on a product.
+// Only one active rate may exist for the same agent and product combination.
+func (s *service) CreateCommissionRate(ctx context.Context, req payload.CommissionRateCreateRequest) (*payload.CommissionRateResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CreateCommissionRate")
+	defer span.End()
+
+	if req.AgentID == nil && req.ProductID == nil {
+		return nil, ErrRateScopeRequired()
+	}
+
+	percent, err := parsePercent(req.Percent)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *model.CommissionRate
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		if req.AgentID != nil {
+			_, err := s.findAgent(ctx, uow, *req.AgentID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if req.ProductID != nil {
+			_, err := uow.ProductRepository().FindByID(ctx, *req.ProductID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return product.ErrProductNotFound(err)
+				}
+
+				return err
+			}
+		}
+
+		created, err = uow.CommissionRateRepository().Save(ctx, &model.CommissionRate{
+			AgentID:   req.AgentID,
+			ProductID: req.ProductID,
+			Percent:   percent,
+			CreatedBy: actor.FromContext(ctx).JSON(),
+		})
+		if dberror.GetSQLState(err) == dberror.UniqueViolation {
+			return ErrCommissionRateExists(err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toRateResponse(created)
+}
+
+// GetAllCommissionRates retrieves a list of commission rates with support for pagination and filtering.
+func (s *service) GetAllCommissionRates(ctx context.Context, req payload.CommissionRateGetAllRequest) ([]payload.CommissionRateResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllCommissionRates")
+	defer span.End()
+
+	if req.CommissionRateFilter == nil {
+		req.CommissionRateFilter = &model.CommissionRateFilter{}
+	}
+
+	var count int64
+	var rates []model.CommissionRate
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.CommissionRateRepository().Count(groupCtx, req.CommissionRateFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		rates, err = s.uow.CommissionRateRepository().FindAll(groupCtx, req.Page, req.Size, req.CommissionRateFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := []payload.CommissionRateResponse{}
+	err = s.mapper.ToResponse(rates, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// UpdateCommissionRate changes the percentage of a commission rate. Commissions already earned are not recalculated.
+func (s *service) UpdateCommissionRate(ctx context.Context, id uint, req payload.CommissionRateUpdateRequest) (*payload.CommissionRateResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "UpdateCommissionRate")
+	defer span.End()
+
+	percent, err := parsePercent(req.Percent)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := s.uow.CommissionRateRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCommissionRateNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	rate.Percent = percent
+
+	updated, err := s.uow.CommissionRateRepository().Update(ctx, rate)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toRateResponse(updated)
+}
+
+// DeleteCommissionRate removes a commission rate. Commissions already earned with it are kept.
+func (s *service) DeleteCommissionRate(ctx context.Context, id uint) error {
+	ctx, span := serviceTracer.Start(ctx, "DeleteCommissionRate")
+	defer span.End()
+
+	_, err := s.uow.CommissionRateRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCommissionRateNotFound(err)
+		}
+
+		return err
+	}
+
+	return s.uow.CommissionRateRepository().Delete(ctx, id)
+}
+
+// GetAgentBookings retrieves the bookings attributed to an agent with support for pagination and filtering.
+// Agents can only list their own bookings.
+func (s *service) GetAgentBookings(ctx context.Context, agentID uint, req payload.BookingGetAllRequest) ([]payload.BookingBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAgentBookings")
+	defer span.End()
+
+	err := authorize(ctx, agentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = s.findAgent(ctx, s.uow, agentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.BookingFilter == nil {
+		req.BookingFilter = &model.BookingFilter{}
+	}
+	req.BookingFilter.AgentID = &agentID
+
+	var count int64
+	var bookings []model.Booking
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.BookingRepository().Count(groupCtx, req.BookingFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		bookings, err = s.uow.BookingRepository().FindAll(groupCtx, req.Page, req.Size, req.BookingFilter)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := []payload.BookingBaseResponse{}
+	err = s.mapper.ToResponse(bookings, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetAgentCommissions retrieves the commissions earned by an agent with support for pagination and
+// filtering by period. Agents can only list their own earnings.
+func (s *service) GetAgentCommissions(ctx context.Context, agentID uint, req payload.AgentCommissionGetAllRequest) ([]payload.AgentCommissionResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAgentCommissions")
+	defer span.End()
+
+	err := authorize(ctx, agentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = s.findAgent(ctx, s.uow, agentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.AgentCommissionFilter == nil {
+		req.AgentCommissionFilter = &model.AgentCommissionFilter{}
+	}
+	req.AgentCommissionFilter.AgentID = &agentID
+
+	var count int64
+	var commissions []model.AgentCommission
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.AgentCommissionRepository().Count(groupCtx, req.AgentCommissionFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		commissions, err = s.uow.AgentCommissionRepository().FindAll(groupCtx, req.Page, req.Size, req.AgentCommissionFilter)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := []payload.AgentCommissionResponse{}
+	err = s.mapper.ToResponse(commissions, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetAgentCommissionStatements retrieves the commission totals of an agent per period (YYYY-MM),
+// latest period first. Agents can only view their own statements.
+func (s *service) GetAgentCommissionStatements(ctx context.Context, agentID uint) ([]payload.CommissionStatementResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAgentCommissionStatements")
+	defer span.End()
+
+	err := authorize(ctx, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.findAgent(ctx, s.uow, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := s.uow.AgentCommissionRepository().FindStatements(ctx, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]payload.CommissionStatementResponse, 0, len(statements))
+	for _, st := range statements {
+		resp = append(resp, payload.CommissionStatementResponse{
+			Period:        st.Period,
+			BookingCount:  st.BookingCount,
+			BookingAmount: st.BookingAmount.StringFixed(2),
+			Amount:        st.Amount.StringFixed(2),
+		})
+	}
+
+	return resp, nil
+}
+
+// findAgent retrieves an admin account that has the agent role.
+func (s *service) findAgent(ctx context.Context, uow contract.UnitOfWork, id uint) (*model.Admin, error) {
+	a, err := uow.AdminRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAgentNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	if a.Role != model.AdminRoleAgent {
+		return nil, ErrAgentNotFound(nil)
+	}
+
+	return a, nil
+}
+
+// toAgentResponse maps an agent account to the response DTO.
+func (s *service) toAgentResponse(a *model.Admin) (*payload.AgentResponse, error) {
+	var resp payload.AgentResponse
+	err := s.mapper.ToResponse(a, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// toRateResponse maps a commission rate to the response DTO.
+func (s *service) toRateResponse(rate *model.CommissionRate) (*payload.CommissionRateResponse, error) {
+	var resp payload.CommissionRateResponse
+	err := s.mapper.ToResponse(rate, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// authorize checks that the actor may view the bookings and earnings of the given agent.
+// An agent only sees their own; customers and muthawif see none; staff and the SYSTEM actor see all.
+func authorize(ctx context.Context, agentID uint) error {
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.AdminRoleAgent:
+		if a.ID != agentID {
+			return ErrAgentForbidden()
+		}
+	case model.UserRoleCustomer, model.UserRoleMuthawif, model.AdminRoleFinInst:
+		return ErrAgentForbidden()
+	}
+
+	return nil
+}
+
+// parsePercent parses a commission percentage, which must be in the (0, 100] range.
+func parsePercent(s string) (decimal.Decimal, error) {
+	percent, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, ErrInvalidPercent(err)
+	}
+	if !percent.IsPositive() || percent.GreaterThan(decimal.NewFromInt(100)) {
+		return decimal.Zero, ErrInvalidPercent(nil)
+	}
+
+	return percent, nil
+}
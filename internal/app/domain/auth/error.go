@@ -0,0 +1,59 @@
+package auth
+
+import "github.com/aburizalpurnama/travel/internal/pkg/apperror"
+
+// ==========================================================
+// Auth Error Constructors
+// ==========================================================
+
+// ErrInvalidCredentials creates a new error for a failed login.
+// Unknown logins, wrong passwords and inactive accounts are reported alike so accounts cannot be probed.
+func ErrInvalidCredentials(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthenticated,
+		"invalid login or password",
+		err,
+		nil,
+	)
+}
+
+// ErrInvalidRefreshToken creates a new error for an unknown or revoked refresh token.
+func ErrInvalidRefreshToken(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthenticated,
+		"invalid refresh token",
+		err,
+		nil,
+	)
+}
+
+// ErrRefreshTokenExpired creates a new error for a refresh token used after its expiry.
+func ErrRefreshTokenExpired() *apperror.AppError {
+	return apperror.New(
+		apperror.TokenExpired,
+		"refresh token has expired",
+		nil,
+		nil,
+	)
+}
+
+// ErrRefreshTokenReused creates a new error for a refresh token that was already exchanged.
+// Reuse means the token may have been stolen, so the whole session is ended.
+func ErrRefreshTokenReused() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthenticated,
+		"refresh token was already used, the session has been ended",
+		nil,
+		nil,
+	)
+}
+
+// ErrNoSession creates a new error for logging out without an authenticated session.
+func ErrNoSession() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthenticated,
+		"no authenticated session",
+		nil,
+		nil,
+	)
+}
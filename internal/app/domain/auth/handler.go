@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("auth.handler")
+
+type Handler struct {
+	service contract.AuthService
+}
+
+// NewHandler initializes a new instance of AuthHandler.
+func NewHandler(service contract.AuthService) *Handler {
+	return &Handler{service: service}
+}
+
+// Login handles a login with an email address or phone number and a password.
+func (h *Handler) Login(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "Login")
+	defer span.End()
+
+	var req payload.LoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	token, err := h.service.Login(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(token, nil))
+}
+
+// AdminLogin handles an admin login with an email address and a password.
+func (h *Handler) AdminLogin(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "AdminLogin")
+	defer span.End()
+
+	var req payload.AdminLoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	token, err := h.service.AdminLogin(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(token, nil))
+}
+
+// Refresh handles exchanging a refresh token for a new token pair.
+func (h *Handler) Refresh(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "Refresh")
+	defer span.End()
+
+	var req payload.RefreshTokenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	token, err := h.service.Refresh(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(token, nil))
+}
+
+// Logout handles ending the current session.
+func (h *Handler) Logout(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "Logout")
+	defer span.End()
+
+	if err := h.service.Logout(ctx); err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success("success logout", nil))
+}
+
+// LogoutAll handles ending every session of the current principal.
+func (h *Handler) LogoutAll(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "LogoutAll")
+	defer span.End()
+
+	if err := h.service.LogoutAll(ctx); err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success("success logout from all sessions", nil))
+}
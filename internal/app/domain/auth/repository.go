@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("auth.repository")
+
+// RefreshTokenRepository implements the contract.RefreshTokenRepository interface.
+type RefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository creates a new refresh token repository instance.
+func NewRefreshTokenRepository(db *gorm.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.RefreshTokenRepository = (*RefreshTokenRepository)(nil)
+
+// FindByHashForUpdate retrieves the refresh token with the given hash and locks the row until the transaction ends.
+// Used and revoked tokens are returned as well, so reuse can be detected.
+func (r *RefreshTokenRepository) FindByHashForUpdate(ctx context.Context, hash string) (*model.RefreshToken, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByHashForUpdate")
+	defer span.End()
+
+	var data model.RefreshToken
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_on IS NULL AND token_hash = ?", hash).
+		First(&data).Error
+	return &data, err
+}
+
+// FindActiveFamilyIDs returns the families, that is the login sessions, of a principal that are not revoked.
+func (r *RefreshTokenRepository) FindActiveFamilyIDs(ctx context.Context, principalID uint, principalRole string) ([]string, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindActiveFamilyIDs")
+	defer span.End()
+
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Distinct("family_id").
+		Where("deleted_on IS NULL AND revoked_on IS NULL AND principal_id = ? AND principal_role = ?", principalID, principalRole).
+		Pluck("family_id", &ids).Error
+	return ids, err
+}
+
+// Save persists a new refresh token record to the database.
+func (r *RefreshTokenRepository) Save(ctx context.Context, token *model.RefreshToken) (*model.RefreshToken, error) {
+	ctx, span := repositoryTracer.Start(ctx, "Save")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Create(token).Error
+	return token, err
+}
+
+// Update modifies an existing refresh token record in the database.
+func (r *RefreshTokenRepository) Update(ctx context.Context, token *model.RefreshToken) (*model.RefreshToken, error) {
+	ctx, span := repositoryTracer.Start(ctx, "Update")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Save(token).Error
+	return token, err
+}
+
+// RevokeFamilies revokes every token of the given families that is not revoked yet.
+func (r *RefreshTokenRepository) RevokeFamilies(ctx context.Context, familyIDs []string, at time.Time, by datatypes.JSON) error {
+	ctx, span := repositoryTracer.Start(ctx, "RevokeFamilies")
+	defer span.End()
+
+	if len(familyIDs) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("deleted_on IS NULL AND revoked_on IS NULL AND family_id IN ?", familyIDs).
+		Updates(map[string]any{"revoked_on": at, "modified_on": at, "modified_by": by}).Error
+}
+
+// AccessTokenDenylistRepository implements the contract.AccessTokenDenylistRepository interface.
+type AccessTokenDenylistRepository struct {
+	db *gorm.DB
+}
+
+// NewAccessTokenDenylistRepository creates a new access token denylist repository instance.
+func NewAccessTokenDenylistRepository(db *gorm.DB) *AccessTokenDenylistRepository {
+	return &AccessTokenDenylistRepository{db: db}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.AccessTokenDenylistRepository = (*AccessTokenDenylistRepository)(nil)
+
+// Deny adds sessions to the denylist, extending the expiry of sessions that are already on it.
+// Entries that have expired are pruned along the way.
+func (r *AccessTokenDenylistRepository) Deny(ctx context.Context, entries []model.AccessTokenDenial) error {
+	ctx, span := repositoryTracer.Start(ctx, "Deny")
+	defer span.End()
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "session_id"}},
+			DoUpdates: clause.Assignments(map[string]any{"expires_on": gorm.Expr("GREATEST(access_token_denylist.expires_on, EXCLUDED.expires_on)")}),
+		}).
+		Create(&entries).Error
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).
+		Unscoped().
+		Where("expires_on < ?", time.Now()).
+		Delete(&model.AccessTokenDenial{}).Error
+}
+
+// IsDenied reports whether the access tokens of the given session are denied.
+func (r *AccessTokenDenylistRepository) IsDenied(ctx context.Context, sessionID string) (bool, error) {
+	ctx, span := repositoryTracer.Start(ctx, "IsDenied")
+	defer span.End()
+
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.AccessTokenDenial{}).
+		Where("session_id = ? AND expires_on > ?", sessionID, time.Now()).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
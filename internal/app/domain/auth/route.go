@@ -0,0 +1,14 @@
+package auth
+
+import "github.com/gofiber/fiber/v2"
+
+// NewRoute registers authentication routes to the provided router group.
+func NewRoute(router fiber.Router, handler *Handler) {
+	auth := router.Group("/auth")
+
+	auth.Post("/login", handler.Login)
+	auth.Post("/admin/login", handler.AdminLogin)
+	auth.Post("/refresh", handler.Refresh)
+	auth.Post("/logout", handler.Logout)
+	auth.Post("/logout-all", handler.LogoutAll)
+}
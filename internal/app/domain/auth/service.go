@@ -0,0 +1,332 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/token"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("auth.service")
+
+// Option holds the token lifetimes used by the auth service.
+type Option struct {
+	AccessTokenTTL  time.Duration // Lifetime of access tokens, used to keep ended sessions on the denylist
+	RefreshTokenTTL time.Duration // Lifetime of each refresh token
+}
+
+type service struct {
+	uow    contract.UnitOfWork
+	hasher contract.PasswordHasher
+	tokens contract.AccessTokenManager
+	opt    Option
+}
+
+// NewService initializes a new instance of auth service.
+func NewService(uow contract.UnitOfWork, hasher contract.PasswordHasher, tokens contract.AccessTokenManager, opt Option) *service {
+	return &service{uow: uow, hasher: hasher, tokens: tokens, opt: opt}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.AuthService = (*service)(nil)
+
+// Login verifies a user's email address or phone number and password and starts a new session,
+// issuing an access token carrying the user as the principal and a refresh token.
+// Accounts without a password or that are inactive cannot log in.
+func (s *service) Login(ctx context.Context, req payload.LoginRequest) (*payload.TokenResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "Login")
+	defer span.End()
+
+	u, err := s.uow.UserRepository().FindByLogin(ctx, strings.TrimSpace(req.Login))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidCredentials(err)
+		}
+
+		return nil, err
+	}
+
+	if u.PasswordHash == nil || (u.IsActive != nil && !*u.IsActive) {
+		return nil, ErrInvalidCredentials(nil)
+	}
+
+	ok, err := s.hasher.Verify(*u.PasswordHash, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidCredentials(nil)
+	}
+
+	principal := actor.Actor{ID: u.ID, UID: u.UID, Name: u.FullName, Role: u.Role, SessionID: uuid.NewString()}
+
+	resp, _, err := s.issue(ctx, s.uow, principal)
+	return resp, err
+}
+
+// AdminLogin verifies an admin's email address and password and starts a new session,
+// issuing an access token carrying the admin as the principal and a refresh token.
+// Admins log in separately from users, so a user and an admin sharing an email address stay apart.
+func (s *service) AdminLogin(ctx context.Context, req payload.AdminLoginRequest) (*payload.TokenResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "AdminLogin")
+	defer span.End()
+
+	a, err := s.uow.AdminRepository().FindByEmail(ctx, strings.TrimSpace(req.Email))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidCredentials(err)
+		}
+
+		return nil, err
+	}
+
+	if a.PasswordHash == nil || (a.IsActive != nil && !*a.IsActive) {
+		return nil, ErrInvalidCredentials(nil)
+	}
+
+	ok, err := s.hasher.Verify(*a.PasswordHash, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidCredentials(nil)
+	}
+
+	principal := adminPrincipal(a)
+	principal.SessionID = uuid.NewString()
+
+	resp, _, err := s.issue(ctx, s.uow, principal)
+	return resp, err
+}
+
+// Refresh exchanges a refresh token for a new access token and refresh token of the same session.
+// Each refresh token can be used once. Presenting one that was already exchanged ends the whole session,
+// since either the client or an attacker holds a stolen copy.
+func (s *service) Refresh(ctx context.Context, req payload.RefreshTokenRequest) (*payload.TokenResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "Refresh")
+	defer span.End()
+
+	var resp *payload.TokenResponse
+	var reused bool
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		current, err := uow.RefreshTokenRepository().FindByHashForUpdate(ctx, token.HashOpaque(req.RefreshToken))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRefreshToken(err)
+			}
+
+			return err
+		}
+
+		now := time.Now()
+		if current.RevokedOn != nil {
+			return ErrInvalidRefreshToken(nil)
+		}
+
+		// The session is ended in this transaction and the reuse reported once it is committed
+		if current.UsedOn != nil {
+			reused = true
+			return s.endSessions(ctx, uow, []string{current.FamilyID}, now)
+		}
+
+		if !now.Before(current.ExpiresOn) {
+			return ErrRefreshTokenExpired()
+		}
+
+		principal, err := findPrincipal(ctx, uow, current.PrincipalID, current.PrincipalRole)
+		if err != nil {
+			return err
+		}
+		principal.SessionID = current.FamilyID
+
+		// Refreshing is public, so the rotation is recorded as done by the token's principal
+		ctx = actor.NewContext(ctx, principal)
+
+		var next *model.RefreshToken
+		resp, next, err = s.issue(ctx, uow, principal)
+		if err != nil {
+			return err
+		}
+
+		current.UsedOn = &now
+		current.ReplacedByID = &next.ID
+		current.ModifiedOn = &now
+		current.ModifiedBy = principal.JSON()
+
+		_, err = uow.RefreshTokenRepository().Update(ctx, current)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reused {
+		return nil, ErrRefreshTokenReused()
+	}
+
+	return resp, nil
+}
+
+// Logout ends the session of the current access token: its refresh tokens are revoked
+// and its access tokens denied until they expire.
+func (s *service) Logout(ctx context.Context) error {
+	ctx, span := serviceTracer.Start(ctx, "Logout")
+	defer span.End()
+
+	a := actor.FromContext(ctx)
+	if a.SessionID == "" {
+		return ErrNoSession()
+	}
+
+	return s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		return s.endSessions(ctx, uow, []string{a.SessionID}, time.Now())
+	})
+}
+
+// LogoutAll ends every session of the current principal, logging it out of all devices.
+func (s *service) LogoutAll(ctx context.Context) error {
+	ctx, span := serviceTracer.Start(ctx, "LogoutAll")
+	defer span.End()
+
+	a := actor.FromContext(ctx)
+	if a.SessionID == "" {
+		return ErrNoSession()
+	}
+
+	return s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		familyIDs, err := uow.RefreshTokenRepository().FindActiveFamilyIDs(ctx, a.ID, a.Role)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(familyIDs, a.SessionID) {
+			familyIDs = append(familyIDs, a.SessionID)
+		}
+
+		return s.endSessions(ctx, uow, familyIDs, time.Now())
+	})
+}
+
+// EndPrincipalSessions ends every session of a principal within the caller's transaction,
+// for instance when its account is disabled or its role changes.
+func (s *service) EndPrincipalSessions(ctx context.Context, uow contract.UnitOfWork, principalID uint, role string) error {
+	familyIDs, err := uow.RefreshTokenRepository().FindActiveFamilyIDs(ctx, principalID, role)
+	if err != nil {
+		return err
+	}
+
+	return s.endSessions(ctx, uow, familyIDs, time.Now())
+}
+
+// issue creates a new refresh token for the principal's session and returns it along with the token response.
+func (s *service) issue(ctx context.Context, uow contract.UnitOfWork, principal actor.Actor) (*payload.TokenResponse, *model.RefreshToken, error) {
+	raw, hash, err := token.NewOpaque()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken, err := uow.RefreshTokenRepository().Save(ctx, &model.RefreshToken{
+		PrincipalID:   principal.ID,
+		PrincipalRole: principal.Role,
+		FamilyID:      principal.SessionID,
+		TokenHash:     hash,
+		ExpiresOn:     time.Now().Add(s.opt.RefreshTokenTTL),
+		CreatedBy:     principal.JSON(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accessToken, expiresAt, err := s.tokens.Issue(principal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &payload.TokenResponse{
+		AccessToken:           accessToken,
+		TokenType:             "Bearer",
+		ExpiresIn:             int64(time.Until(expiresAt).Seconds()),
+		ExpiresAt:             expiresAt,
+		RefreshToken:          raw,
+		RefreshTokenExpiresAt: refreshToken.ExpiresOn,
+	}, refreshToken, nil
+}
+
+// endSessions revokes the refresh tokens of the given sessions and puts their access tokens on the denylist
+// for as long as they can still be valid. It must be called within a transaction.
+func (s *service) endSessions(ctx context.Context, uow contract.UnitOfWork, sessionIDs []string, now time.Time) error {
+	by := actor.FromContext(ctx).JSON()
+
+	err := uow.RefreshTokenRepository().RevokeFamilies(ctx, sessionIDs, now, by)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]model.AccessTokenDenial, 0, len(sessionIDs))
+	for _, id := range sessionIDs {
+		entries = append(entries, model.AccessTokenDenial{
+			SessionID: id,
+			ExpiresOn: now.Add(s.opt.AccessTokenTTL),
+			CreatedBy: by,
+		})
+	}
+
+	return uow.AccessTokenDenylistRepository().Deny(ctx, entries)
+}
+
+// findPrincipal loads the principal a refresh token was issued for from the users or admins, based on its role.
+// Principals that no longer exist, were deactivated or changed roles cannot refresh their session.
+func findPrincipal(ctx context.Context, uow contract.UnitOfWork, id uint, role string) (actor.Actor, error) {
+	switch role {
+	case model.AdminRoleAgent, model.AdminRoleAdmin, model.AdminRoleFinInst:
+		a, err := uow.AdminRepository().FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return actor.Actor{}, ErrInvalidRefreshToken(err)
+			}
+
+			return actor.Actor{}, err
+		}
+
+		if a.Role != role || (a.IsActive != nil && !*a.IsActive) {
+			return actor.Actor{}, ErrInvalidRefreshToken(nil)
+		}
+
+		return adminPrincipal(a), nil
+	}
+
+	u, err := uow.UserRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return actor.Actor{}, ErrInvalidRefreshToken(err)
+		}
+
+		return actor.Actor{}, err
+	}
+
+	if u.Role != role || (u.IsActive != nil && !*u.IsActive) {
+		return actor.Actor{}, ErrInvalidRefreshToken(nil)
+	}
+
+	return actor.Actor{ID: u.ID, UID: u.UID, Name: u.FullName, Role: u.Role}, nil
+}
+
+// adminPrincipal returns the principal of an admin, carrying its role level for authorization.
+func adminPrincipal(a *model.Admin) actor.Actor {
+	principal := actor.Actor{ID: a.ID, UID: a.UID, Name: a.FullName, Role: a.Role}
+	if a.RoleLevel != nil {
+		principal.Level = *a.RoleLevel
+	}
+	return principal
+}
@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/apptest"
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/token"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func TestRefreshRotatesToken(t *testing.T) {
+	uow := newFakeUoW()
+	uow.tokens.add("raw-1", time.Now().Add(time.Hour))
+	s := newTestService(uow)
+
+	resp, err := s.Refresh(context.Background(), payload.RefreshTokenRequest{RefreshToken: "raw-1"})
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if resp.AccessToken == "" || resp.RefreshToken == "" || resp.RefreshToken == "raw-1" {
+		t.Fatalf("Refresh() = %+v, want a new access token and refresh token", resp)
+	}
+
+	used := uow.tokens.byHash(token.HashOpaque("raw-1"))
+	next := uow.tokens.byHash(token.HashOpaque(resp.RefreshToken))
+	if next == nil {
+		t.Fatal("rotated refresh token was not saved")
+	}
+	if used.UsedOn == nil || used.ReplacedByID == nil || *used.ReplacedByID != next.ID {
+		t.Errorf("used token = %+v, want it marked used and replaced by token %d", used, next.ID)
+	}
+	if next.FamilyID != used.FamilyID || next.UsedOn != nil || next.RevokedOn != nil {
+		t.Errorf("rotated token = %+v, want an unused token of session %s", next, used.FamilyID)
+	}
+
+	// The rotated token can be exchanged in turn
+	_, err = s.Refresh(context.Background(), payload.RefreshTokenRequest{RefreshToken: resp.RefreshToken})
+	apptest.AssertError(t, err, nil)
+}
+
+// TestRefreshDetectsReuse presents a refresh token that was already exchanged. The whole session must be
+// ended: every token of its family revoked, including the one it was rotated into, and its access tokens denied.
+func TestRefreshDetectsReuse(t *testing.T) {
+	uow := newFakeUoW()
+	uow.tokens.add("raw-1", time.Now().Add(time.Hour))
+	s := newTestService(uow)
+
+	resp, err := s.Refresh(context.Background(), payload.RefreshTokenRequest{RefreshToken: "raw-1"})
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	_, err = s.Refresh(context.Background(), payload.RefreshTokenRequest{RefreshToken: "raw-1"})
+	apptest.AssertError(t, err, ErrRefreshTokenReused())
+
+	for _, rt := range uow.tokens.tokens {
+		if rt.RevokedOn == nil {
+			t.Errorf("token %d of the reused session is not revoked", rt.ID)
+		}
+	}
+	if len(uow.denylist.denied) != 1 || uow.denylist.denied[0].SessionID != testFamilyID {
+		t.Errorf("denied sessions = %+v, want session %s", uow.denylist.denied, testFamilyID)
+	}
+
+	_, err = s.Refresh(context.Background(), payload.RefreshTokenRequest{RefreshToken: resp.RefreshToken})
+	apptest.AssertError(t, err, ErrInvalidRefreshToken(nil))
+}
+
+func TestRefreshRejectsToken(t *testing.T) {
+	inactive := false
+
+	tests := []struct {
+		name   string
+		raw    string
+		modify func(uow *fakeUoW)
+		want   *apperror.AppError
+	}{
+		{"unknown", "raw-2", func(uow *fakeUoW) {}, ErrInvalidRefreshToken(nil)},
+		{"expired", "raw-1", func(uow *fakeUoW) { uow.tokens.tokens[0].ExpiresOn = time.Now().Add(-time.Second) }, ErrRefreshTokenExpired()},
+		{"revoked", "raw-1", func(uow *fakeUoW) { now := time.Now(); uow.tokens.tokens[0].RevokedOn = &now }, ErrInvalidRefreshToken(nil)},
+		{"principal deactivated", "raw-1", func(uow *fakeUoW) { uow.users.user.IsActive = &inactive }, ErrInvalidRefreshToken(nil)},
+		{"principal role changed", "raw-1", func(uow *fakeUoW) { uow.users.user.Role = model.UserRoleMuthawif }, ErrInvalidRefreshToken(nil)},
+		{"principal deleted", "raw-1", func(uow *fakeUoW) { uow.users.user = nil }, ErrInvalidRefreshToken(nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uow := newFakeUoW()
+			uow.tokens.add("raw-1", time.Now().Add(time.Hour))
+			tt.modify(uow)
+
+			_, err := newTestService(uow).Refresh(context.Background(), payload.RefreshTokenRequest{RefreshToken: tt.raw})
+			apptest.AssertError(t, err, tt.want)
+
+			if len(uow.tokens.tokens) != 1 || uow.tokens.tokens[0].UsedOn != nil {
+				t.Errorf("tokens = %+v, want the rejected token left unused and no new token", uow.tokens.tokens)
+			}
+		})
+	}
+}
+
+const testFamilyID = "5b0c8f1e-7a47-4c8e-9d0b-2f3c6a1e9b10"
+
+func newTestService(uow *fakeUoW) *service {
+	return NewService(uow, nil, token.NewJWTManager("secret", time.Minute), Option{
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+	})
+}
+
+type fakeUoW struct {
+	*apptest.UnitOfWork
+	tokens   *fakeRefreshTokenRepository
+	denylist *fakeDenylistRepository
+	users    *fakeUserRepository
+}
+
+func newFakeUoW() *fakeUoW {
+	u := &fakeUoW{
+		tokens:   &fakeRefreshTokenRepository{},
+		denylist: &fakeDenylistRepository{},
+		users:    &fakeUserRepository{user: &model.User{ID: 7, UID: "u-7", FullName: "Siti", Role: model.UserRoleCustomer}},
+	}
+	u.UnitOfWork = &apptest.UnitOfWork{RefreshTokens: u.tokens, AccessTokenDenylist: u.denylist, Users: u.users}
+	return u
+}
+
+// fakeRefreshTokenRepository keeps refresh tokens in memory, handing out copies as the database would.
+type fakeRefreshTokenRepository struct {
+	contract.RefreshTokenRepository
+	tokens []*model.RefreshToken
+}
+
+func (r *fakeRefreshTokenRepository) add(raw string, expiresOn time.Time) {
+	r.tokens = append(r.tokens, &model.RefreshToken{
+		ID:            uint(len(r.tokens) + 1),
+		PrincipalID:   7,
+		PrincipalRole: model.UserRoleCustomer,
+		FamilyID:      testFamilyID,
+		TokenHash:     token.HashOpaque(raw),
+		ExpiresOn:     expiresOn,
+	})
+}
+
+func (r *fakeRefreshTokenRepository) byHash(hash string) *model.RefreshToken {
+	for _, rt := range r.tokens {
+		if rt.TokenHash == hash {
+			return rt
+		}
+	}
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) FindByHashForUpdate(_ context.Context, hash string) (*model.RefreshToken, error) {
+	rt := r.byHash(hash)
+	if rt == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	found := *rt
+	return &found, nil
+}
+
+func (r *fakeRefreshTokenRepository) Save(_ context.Context, rt *model.RefreshToken) (*model.RefreshToken, error) {
+	saved := *rt
+	saved.ID = uint(len(r.tokens) + 1)
+	r.tokens = append(r.tokens, &saved)
+	return &saved, nil
+}
+
+func (r *fakeRefreshTokenRepository) Update(_ context.Context, rt *model.RefreshToken) (*model.RefreshToken, error) {
+	updated := *rt
+	r.tokens[rt.ID-1] = &updated
+	return &updated, nil
+}
+
+func (r *fakeRefreshTokenRepository) RevokeFamilies(_ context.Context, familyIDs []string, at time.Time, _ datatypes.JSON) error {
+	for _, rt := range r.tokens {
+		for _, id := range familyIDs {
+			if rt.FamilyID == id && rt.RevokedOn == nil {
+				rt.RevokedOn = &at
+			}
+		}
+	}
+	return nil
+}
+
+type fakeDenylistRepository struct {
+	contract.AccessTokenDenylistRepository
+	denied []model.AccessTokenDenial
+}
+
+func (r *fakeDenylistRepository) Deny(_ context.Context, entries []model.AccessTokenDenial) error {
+	r.denied = append(r.denied, entries...)
+	return nil
+}
+
+type fakeUserRepository struct {
+	contract.UserRepository
+	user *model.User
+}
+
+func (r *fakeUserRepository) FindByID(_ context.Context, id uint) (*model.User, error) {
+	if r.user == nil || r.user.ID != id {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	found := *r.user
+	return &found, nil
+}
@@ -0,0 +1,29 @@
+package booking
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+)
+
+// Authorize checks that the actor may access the given booking and the records attached to it.
+// Customers only access their own bookings and agents the ones attributed to them;
+// muthawif and financing institutions access none; staff and the SYSTEM actor access all.
+func Authorize(ctx context.Context, b *model.Booking) error {
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.UserRoleCustomer:
+		if a.ID != b.UserID {
+			return ErrBookingForbidden()
+		}
+	case model.AdminRoleAgent:
+		if b.AgentID == nil || a.ID != *b.AgentID {
+			return ErrBookingForbidden()
+		}
+	case model.UserRoleMuthawif, model.AdminRoleFinInst:
+		return ErrBookingForbidden()
+	}
+
+	return nil
+}
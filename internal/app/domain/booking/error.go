@@ -0,0 +1,140 @@
+package booking
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Booking Error Constructors
+// ==========================================================
+
+// ErrBookingNotFound creates a new error for missing booking records.
+func ErrBookingNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.BookingNotFound,
+		"booking not found",
+		err,
+		nil,
+	)
+}
+
+// ErrProductNotBookable creates a new error for bookings referencing an inactive product.
+func ErrProductNotBookable() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"product is not available for booking",
+		nil,
+		map[string]any{"product_id": apperror.InvalidValue},
+	)
+}
+
+// ErrUserNotBookable creates a new error for bookings referencing an inactive user.
+func ErrUserNotBookable() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"user is not allowed to make a booking",
+		nil,
+		map[string]any{"user_id": apperror.InvalidValue},
+	)
+}
+
+// ErrBookingAlreadyConfirmed creates a new error for confirming a booking that is already past confirmation.
+func ErrBookingAlreadyConfirmed(status model.BookingStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.BookingAlreadyConfirmed,
+		"booking is already confirmed",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrBookingNotCancellable creates a new error for canceling a booking whose status does not allow it.
+func ErrBookingNotCancellable(status model.BookingStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.BookingNotCancellable,
+		"booking can no longer be canceled",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrInvalidTransition creates a new error for any other status change that is not allowed.
+func ErrInvalidTransition(from, to model.BookingStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking status cannot be changed from "+string(from)+" to "+string(to),
+		nil,
+		map[string]any{"from": from, "to": to},
+	)
+}
+
+// ErrBookingNotEditable creates a new error for modifying a booking that is no longer in the booked status.
+func ErrBookingNotEditable(status model.BookingStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking can only be modified while in booked status",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrBookingCodeUnavailable creates a new error for when no unique booking code could be generated.
+func ErrBookingCodeUnavailable(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Internal,
+		"failed to generate a unique booking code",
+		err,
+		nil,
+	)
+}
+
+// ErrBookingDateFixed creates a new error for changing the date of a booking that follows a departure batch.
+func ErrBookingDateFixed() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"booking date follows its departure batch and cannot be changed",
+		nil,
+		map[string]any{"date": apperror.InvalidValue},
+	)
+}
+
+// ErrBookingQtyFixed creates a new error for changing the quantity of a booking that already has payments or an installment plan.
+func ErrBookingQtyFixed() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking quantity cannot be changed once it has payments or an installment plan",
+		nil,
+		map[string]any{"total_qty": apperror.InvalidValue},
+	)
+}
+
+// ErrInvalidAgent creates a new error for attributing a booking to someone who is not an active agent.
+func ErrInvalidAgent(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"agent does not exist or is not active",
+		err,
+		map[string]any{"agent_id": apperror.InvalidValue},
+	)
+}
+
+// ErrUserRequired creates a new error for bookings made on behalf of a customer without naming the customer.
+func ErrUserRequired() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		nil,
+		map[string]any{"user_id": apperror.IsRequired},
+	)
+}
+
+// ErrBookingForbidden creates a new error for accessing the bookings of another user or agent.
+func ErrBookingForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"bookings can only be accessed by their customer or agent",
+		nil,
+		nil,
+	)
+}
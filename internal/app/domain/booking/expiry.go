@@ -0,0 +1,107 @@
+package booking
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var workerTracer trace.Tracer = otel.Tracer("booking.expiry_worker")
+
+// expiryReason is recorded in the status history of every booking canceled by the worker.
+const expiryReason = "payment deadline exceeded"
+
+// ExpiryOption holds the configuration of the ExpiryWorker.
+type ExpiryOption struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// ExpiryWorker periodically cancels bookings that have not received any payment by their max_payment_time.
+// Bookings are claimed with FOR UPDATE SKIP LOCKED, so several replicas can run the worker at the same time.
+type ExpiryWorker struct {
+	uow    contract.UnitOfWork
+	opt    ExpiryOption
+	logger *slog.Logger
+}
+
+// NewExpiryWorker initializes a new instance of the booking expiry worker.
+func NewExpiryWorker(uow contract.UnitOfWork, opt ExpiryOption, logger *slog.Logger) *ExpiryWorker {
+	if opt.Interval <= 0 {
+		opt.Interval = time.Minute
+	}
+	if opt.BatchSize <= 0 {
+		opt.BatchSize = 100
+	}
+
+	return &ExpiryWorker{uow: uow, opt: opt, logger: logger.With("worker", "booking_expiry")}
+}
+
+// Run executes the expiry job on every interval until the context is canceled.
+func (w *ExpiryWorker) Run(ctx context.Context) {
+	w.logger.Info("Booking expiry worker started", "interval", w.opt.Interval.String(), "batch_size", w.opt.BatchSize)
+
+	ticker := time.NewTicker(w.opt.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Booking expiry worker stopped")
+			return
+		case <-ticker.C:
+			// Drain every expired booking before waiting for the next tick
+			for {
+				expired, err := w.ExpireOnce(ctx)
+				if err != nil {
+					w.logger.Error("Failed to expire unpaid bookings", "error", err.Error())
+					break
+				}
+				if expired > 0 {
+					w.logger.Info("Expired unpaid bookings", "count", expired)
+				}
+				if expired < w.opt.BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// ExpireOnce cancels a single batch of expired bookings within one transaction and returns how many were canceled.
+// The cancellation is attributed to the SYSTEM actor and recorded in the booking status history.
+func (w *ExpiryWorker) ExpireOnce(ctx context.Context) (int, error) {
+	ctx, span := workerTracer.Start(ctx, "ExpireOnce")
+	defer span.End()
+
+	ctx = actor.NewContext(ctx, actor.Actor{UID: actor.SystemUID, Name: "booking-expiry-worker"})
+	reason := expiryReason
+
+	var expired int
+	err := w.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		bookings, err := uow.BookingRepository().FindExpiredForUpdate(ctx, time.Now(), w.opt.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range bookings {
+			if err := Transition(ctx, uow, &bookings[i], model.BookingStatusCanceled, &reason); err != nil {
+				return err
+			}
+		}
+
+		expired = len(bookings)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,320 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("booking.handler")
+
+type Handler struct {
+	service contract.BookingService
+}
+
+// NewHandler initializes a new instance of BookingHandler.
+func NewHandler(service contract.BookingService) *Handler {
+	return &Handler{service: service}
+}
+
+// CreateBooking handles the creation of a new booking.
+func (h *Handler) CreateBooking(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "CreateBooking")
+	defer span.End()
+
+	var req payload.BookingCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	booking, err := h.service.CreateBooking(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(booking, nil))
+}
+
+// GetBookings retrieves a list of bookings with pagination and filtering.
+func (h *Handler) GetBookings(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookings")
+	defer span.End()
+
+	req := payload.BookingGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	req.SetDefault()
+
+	bookings, pagination, err := h.service.GetAllBookings(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(bookings, pagination))
+}
+
+// GetBooking retrieves a single booking by its ID.
+func (h *Handler) GetBooking(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBooking")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	booking, err := h.service.GetBookingByID(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(booking, nil))
+}
+
+// GetBookingByCode retrieves a single booking by its booking code.
+func (h *Handler) GetBookingByCode(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookingByCode")
+	defer span.End()
+
+	booking, err := h.service.GetBookingByCode(ctx, c.Params("code"))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(booking, nil))
+}
+
+// UpdateBooking modifies an existing booking based on ID and payload.
+func (h *Handler) UpdateBooking(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "UpdateBooking")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.BookingUpdateRequest
+	err = c.BodyParser(&req)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	err = validate.Struct(req)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	booking, err := h.service.UpdateBooking(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(booking, nil))
+}
+
+// DeleteBooking removes a booking by its ID.
+func (h *Handler) DeleteBooking(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "DeleteBooking")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	if err := h.service.DeleteBooking(ctx, uint(id)); err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success("success delete data", nil))
+}
+
+// ConfirmBooking moves a booking from booked to confirmed.
+func (h *Handler) ConfirmBooking(c *fiber.Ctx) error {
+	return h.transition(c, "ConfirmBooking", h.service.ConfirmBooking)
+}
+
+// StartTrip moves a booking from confirmed to on-trip.
+func (h *Handler) StartTrip(c *fiber.Ctx) error {
+	return h.transition(c, "StartTrip", h.service.StartTrip)
+}
+
+// CompleteBooking moves a booking from on-trip to done.
+func (h *Handler) CompleteBooking(c *fiber.Ctx) error {
+	return h.transition(c, "CompleteBooking", h.service.CompleteBooking)
+}
+
+// CancelBooking cancels a booking that has not started its trip yet.
+func (h *Handler) CancelBooking(c *fiber.Ctx) error {
+	return h.transition(c, "CancelBooking", h.service.CancelBooking)
+}
+
+// GetBookingStatusHistories retrieves the status transitions of a booking.
+func (h *Handler) GetBookingStatusHistories(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookingStatusHistories")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	histories, err := h.service.GetBookingStatusHistories(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(histories, nil))
+}
+
+// transitionFunc is the signature shared by every booking lifecycle operation of the service.
+type transitionFunc func(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error)
+
+// transition parses a lifecycle request and delegates it to the given service operation.
+func (h *Handler) transition(c *fiber.Ctx, name string, fn transitionFunc) error {
+	ctx, span := handlerTracer.Start(c.Context(), name)
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	// The body is optional for lifecycle transitions
+	var req payload.BookingTransitionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+		}
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	booking, err := fn(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(booking, nil))
+}
@@ -0,0 +1,44 @@
+package booking
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var historyRepositoryTracer trace.Tracer = otel.Tracer("booking.history_repository")
+
+// StatusHistoryRepository implements the contract.BookingStatusHistoryRepository interface.
+type StatusHistoryRepository struct {
+	*repository.GORM[model.BookingStatusHistory, model.BookingStatusHistoryFilter]
+	db *gorm.DB
+}
+
+// NewStatusHistoryRepository creates a new booking status history repository instance.
+func NewStatusHistoryRepository(db *gorm.DB) *StatusHistoryRepository {
+	return &StatusHistoryRepository{
+		GORM: repository.NewGORM[model.BookingStatusHistory, model.BookingStatusHistoryFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.BookingStatusHistoryRepository = (*StatusHistoryRepository)(nil)
+
+// FindByBookingID retrieves the status history of a booking ordered from the oldest transition.
+func (r *StatusHistoryRepository) FindByBookingID(ctx context.Context, bookingID uint) ([]model.BookingStatusHistory, error) {
+	ctx, span := historyRepositoryTracer.Start(ctx, "FindByBookingID")
+	defer span.End()
+
+	var data []model.BookingStatusHistory
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND booking_id = ?", bookingID).
+		Order("created_on ASC, id ASC").
+		Find(&data).Error
+	return data, err
+}
@@ -0,0 +1,83 @@
+package booking
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("booking.repository")
+
+// Repository implements the contract.BookingRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Booking, model.BookingFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new booking.repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Booking, model.BookingFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.BookingRepository = (*Repository)(nil)
+
+// Add your custom repository methods below
+
+// FindExpiredForUpdate retrieves and locks bookings that are still in booked status after their payment deadline
+// without having received any payment. Bookings with a partial payment below the down payment stay unpaid
+// but are left out, since the deadline only applies to the first payment.
+// It uses FOR UPDATE SKIP LOCKED so several workers can process expirations concurrently without
+// picking the same booking twice. It must be called within a transaction.
+func (r *Repository) FindExpiredForUpdate(ctx context.Context, now time.Time, limit int) ([]model.Booking, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindExpiredForUpdate")
+	defer span.End()
+
+	var data []model.Booking
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("deleted_on IS NULL").
+		Where("status = ? AND payment_status = ?", model.BookingStatusBooked, model.PaymentStatusUnpaid).
+		Where("total_payment = 0").
+		Where("max_payment_time IS NOT NULL AND max_payment_time < ?", now).
+		Order("max_payment_time ASC").
+		Limit(limit).
+		Find(&data).Error
+	return data, err
+}
+
+// FindByCode retrieves a single active booking by its booking code.
+func (r *Repository) FindByCode(ctx context.Context, code string) (*model.Booking, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByCode")
+	defer span.End()
+
+	var data model.Booking
+	err := r.db.WithContext(ctx).Where("deleted_on IS NULL AND code = ?", code).First(&data).Error
+	return &data, err
+}
+
+// FindActiveByDepartureBatchIDs retrieves the bookings holding seats on the given departure batches,
+// leaving out canceled and refunded ones.
+func (r *Repository) FindActiveByDepartureBatchIDs(ctx context.Context, batchIDs []uint) ([]model.Booking, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindActiveByDepartureBatchIDs")
+	defer span.End()
+
+	var data []model.Booking
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND departure_batch_id IN ?", batchIDs).
+		Where("status NOT IN ?", []model.BookingStatus{model.BookingStatusCanceled, model.BookingStatusRefunded}).
+		Order("id ASC").
+		Find(&data).Error
+	return data, err
+}
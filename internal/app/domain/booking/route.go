@@ -0,0 +1,27 @@
+package booking
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers booking-related routes to the provided router group.
+// Deleting a booking and confirming, starting and completing it require the booking:manage permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	bookings := router.Group("/bookings")
+
+	bookings.Post("/", handler.CreateBooking)
+	bookings.Get("/", handler.GetBookings)
+	bookings.Get("/code/:code", handler.GetBookingByCode)
+	bookings.Get("/:id", handler.GetBooking)
+	bookings.Patch("/:id", handler.UpdateBooking)
+	bookings.Delete("/:id", authz.Require(rbac.BookingManage), handler.DeleteBooking)
+
+	// Lifecycle transitions
+	bookings.Post("/:id/confirm", authz.Require(rbac.BookingManage), handler.ConfirmBooking)
+	bookings.Post("/:id/start-trip", authz.Require(rbac.BookingManage), handler.StartTrip)
+	bookings.Post("/:id/complete", authz.Require(rbac.BookingManage), handler.CompleteBooking)
+	bookings.Post("/:id/cancel", handler.CancelBooking)
+	bookings.Get("/:id/status-histories", handler.GetBookingStatusHistories)
+}
@@ -0,0 +1,606 @@
+package booking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/departure"
+	"github.com/aburizalpurnama/travel/internal/app/domain/product"
+	"github.com/aburizalpurnama/travel/internal/app/domain/user"
+	"github.com/aburizalpurnama/travel/internal/app/domain/voucher"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("booking.service")
+
+const (
+	// maxCodeAttempts is the number of times booking creation is attempted when the generated code collides.
+	maxCodeAttempts = 5
+
+	// codeConstraint is the partial unique index guaranteeing booking codes are unique among active rows.
+	codeConstraint = "ux_bookings_code_active"
+)
+
+// Option holds the configurable business rules of the booking service.
+type Option struct {
+	// PaymentTimeout is the time a new booking has to receive a payment before it expires.
+	// A zero value disables the payment deadline.
+	PaymentTimeout time.Duration
+}
+
+type service struct {
+	uow           contract.UnitOfWork
+	mapper        contract.Mapper
+	codeGenerator contract.BookingCodeGenerator
+	opt           Option
+}
+
+// NewService initializes a new instance of booking service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, codeGenerator contract.BookingCodeGenerator, opt Option) *service {
+	return &service{uow: uow, mapper: mapper, codeGenerator: codeGenerator, opt: opt}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.BookingService = (*service)(nil)
+
+// CreateBooking handles the creation of a new booking record.
+// The product name, user full name and total amount are copied from the referenced product and user
+// so the booking keeps its original values even if those records change later.
+// Customers book for themselves and agents are attributed the bookings they make.
+func (s *service) CreateBooking(ctx context.Context, req payload.BookingCreateRequest) (*payload.BookingBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CreateBooking")
+	defer span.End()
+
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.UserRoleCustomer:
+		req.UserID = a.ID
+	case model.AdminRoleAgent:
+		req.AgentID = &a.ID
+	case model.UserRoleMuthawif, model.AdminRoleFinInst:
+		return nil, ErrBookingForbidden()
+	}
+	if req.UserID == 0 {
+		return nil, ErrUserRequired()
+	}
+
+	// A generated code may collide with an existing one. The failed statement aborts the transaction,
+	// so the whole transaction is retried with a fresh code.
+	var created *model.Booking
+	var err error
+	for attempt := 1; attempt <= maxCodeAttempts; attempt++ {
+		created, err = s.createBooking(ctx, req)
+		if !isCodeConflict(err) {
+			break
+		}
+	}
+	if err != nil {
+		if isCodeConflict(err) {
+			return nil, ErrBookingCodeUnavailable(err)
+		}
+
+		return nil, err
+	}
+
+	var resp payload.BookingBaseResponse
+	err = s.mapper.ToResponse(created, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAllBookings retrieves a list of bookings with support for pagination and filtering.
+// Customers only see their own bookings and agents the bookings attributed to them.
+func (s *service) GetAllBookings(ctx context.Context, req payload.BookingGetAllRequest) ([]payload.BookingBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllBookings")
+	defer span.End()
+
+	if req.BookingFilter == nil {
+		req.BookingFilter = &model.BookingFilter{}
+	}
+
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.UserRoleCustomer:
+		req.BookingFilter.UserID = &a.ID
+	case model.AdminRoleAgent:
+		req.BookingFilter.AgentID = &a.ID
+	case model.UserRoleMuthawif, model.AdminRoleFinInst:
+		return nil, nil, ErrBookingForbidden()
+	}
+
+	var count int64
+	var bookings []model.Booking
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.BookingRepository().Count(groupCtx, req.BookingFilter)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+
+	group.Go(func() error {
+		var err error
+		bookings, err = s.uow.BookingRepository().FindAll(groupCtx, req.Page, req.Size, req.BookingFilter)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp []payload.BookingBaseResponse
+	err = s.mapper.ToResponse(bookings, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetBookingByID retrieves a specific booking by its unique identifier. Only staff can view any booking.
+func (s *service) GetBookingByID(ctx context.Context, id uint) (*payload.BookingBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingByID")
+	defer span.End()
+
+	booking, err := s.uow.BookingRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = Authorize(ctx, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.BookingBaseResponse
+	err = s.mapper.ToResponse(booking, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetBookingByCode retrieves a specific booking by its booking code. The lookup is case-insensitive.
+// Only staff can view any booking.
+func (s *service) GetBookingByCode(ctx context.Context, code string) (*payload.BookingBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingByCode")
+	defer span.End()
+
+	booking, err := s.uow.BookingRepository().FindByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = Authorize(ctx, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.BookingBaseResponse
+	err = s.mapper.ToResponse(booking, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateBooking modifies an existing booking's information. Only staff can update any booking.
+// Changing the quantity recalculates the total amount from the current product price. The quantity is fixed
+// once the booking has received a payment or has an installment plan, as both are reconciled against the total.
+func (s *service) UpdateBooking(ctx context.Context, id uint, req payload.BookingUpdateRequest) (*payload.BookingBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "UpdateBooking")
+	defer span.End()
+
+	var updated *model.Booking
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		booking, err := uow.BookingRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		err = Authorize(ctx, booking)
+		if err != nil {
+			return err
+		}
+
+		if booking.Status != model.BookingStatusBooked {
+			return ErrBookingNotEditable(booking.Status)
+		}
+
+		if req.TotalQty != nil && *req.TotalQty != booking.TotalQty && !qtyEditable(booking) {
+			return ErrBookingQtyFixed()
+		}
+
+		if booking.DepartureBatchID != nil {
+			// The travel date follows the departure batch
+			if req.Date != nil {
+				return ErrBookingDateFixed()
+			}
+
+			if req.TotalQty != nil && booking.ProductID != nil {
+				err = departure.Resize(ctx, uow, *booking.DepartureBatchID, *booking.ProductID, *req.TotalQty-booking.TotalQty)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if req.TotalQty != nil && *req.TotalQty != booking.TotalQty && booking.ProductID != nil {
+			p, err := uow.ProductRepository().FindByID(ctx, *booking.ProductID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return product.ErrProductNotFound(err)
+				}
+
+				return err
+			}
+
+			// A voucher discount is granted once at redemption and never exceeds the subtotal
+			subtotal := p.Price.Mul(decimal.NewFromInt(int64(*req.TotalQty)))
+			if booking.DiscountAmount.GreaterThan(subtotal) {
+				booking.DiscountAmount = subtotal
+			}
+			booking.TotalAmount = subtotal.Sub(booking.DiscountAmount)
+		}
+
+		err = s.mapper.ToModel(req, booking)
+		if err != nil {
+			return err
+		}
+
+		updated, err = uow.BookingRepository().Update(ctx, booking)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.BookingBaseResponse
+	err = s.mapper.ToResponse(updated, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteBooking removes a booking record from the database, returning its seats to the departure batch.
+// Only staff can delete bookings.
+func (s *service) DeleteBooking(ctx context.Context, id uint) error {
+	ctx, span := serviceTracer.Start(ctx, "DeleteBooking")
+	defer span.End()
+
+	return s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		booking, err := uow.BookingRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		err = Authorize(ctx, booking)
+		if err != nil {
+			return err
+		}
+
+		if booking.DepartureBatchID != nil && holdsSeats(booking.Status) {
+			err = departure.Release(ctx, uow, *booking.DepartureBatchID, booking.TotalQty)
+			if err != nil {
+				return err
+			}
+		}
+
+		return uow.BookingRepository().Delete(ctx, id)
+	})
+}
+
+// ConfirmBooking moves a booking from booked to confirmed.
+func (s *service) ConfirmBooking(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ConfirmBooking")
+	defer span.End()
+
+	return s.transition(ctx, id, model.BookingStatusConfirmed, req)
+}
+
+// StartTrip moves a booking from confirmed to on-trip.
+func (s *service) StartTrip(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "StartTrip")
+	defer span.End()
+
+	return s.transition(ctx, id, model.BookingStatusOnTrip, req)
+}
+
+// CompleteBooking moves a booking from on-trip to done.
+func (s *service) CompleteBooking(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CompleteBooking")
+	defer span.End()
+
+	return s.transition(ctx, id, model.BookingStatusDone, req)
+}
+
+// CancelBooking cancels a booking that has not started its trip yet.
+func (s *service) CancelBooking(ctx context.Context, id uint, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CancelBooking")
+	defer span.End()
+
+	return s.transition(ctx, id, model.BookingStatusCanceled, req)
+}
+
+// GetBookingStatusHistories retrieves every recorded status transition of a booking.
+func (s *service) GetBookingStatusHistories(ctx context.Context, id uint) ([]payload.BookingStatusHistoryResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingStatusHistories")
+	defer span.End()
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = Authorize(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	histories, err := s.uow.BookingStatusHistoryRepository().FindByBookingID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]payload.BookingStatusHistoryResponse, 0, len(histories))
+	for _, h := range histories {
+		item := payload.BookingStatusHistoryResponse{
+			ID:        h.ID,
+			ToStatus:  string(h.ToStatus),
+			Reason:    h.Reason,
+			CreatedBy: json.RawMessage(h.CreatedBy),
+		}
+		if h.FromStatus != nil {
+			from := string(*h.FromStatus)
+			item.FromStatus = &from
+		}
+		if h.CreatedOn != nil {
+			item.CreatedOn = *h.CreatedOn
+		}
+		resp = append(resp, item)
+	}
+
+	return resp, nil
+}
+
+// transition locks the booking and applies a status change within a single transaction.
+// Customers and agents can only move their own bookings, which the routes limit to cancellation.
+func (s *service) transition(ctx context.Context, id uint, to model.BookingStatus, req payload.BookingTransitionRequest) (*payload.BookingBaseResponse, error) {
+	var booking *model.Booking
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		booking, err = uow.BookingRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		err = Authorize(ctx, booking)
+		if err != nil {
+			return err
+		}
+
+		return Transition(ctx, uow, booking, to, req.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.BookingBaseResponse
+	err = s.mapper.ToResponse(booking, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// createBooking creates a booking and its initial status history within a single transaction.
+func (s *service) createBooking(ctx context.Context, req payload.BookingCreateRequest) (*model.Booking, error) {
+	var created *model.Booking
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		p, err := uow.ProductRepository().FindByID(ctx, req.ProductID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return product.ErrProductNotFound(err)
+			}
+
+			return err
+		}
+
+		if p.IsActive != nil && !*p.IsActive {
+			return ErrProductNotBookable()
+		}
+
+		u, err := uow.UserRepository().FindByID(ctx, req.UserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return user.ErrUserNotFound(err)
+			}
+
+			return err
+		}
+
+		if u.IsActive != nil && !*u.IsActive {
+			return ErrUserNotBookable()
+		}
+
+		if req.AgentID != nil {
+			a, err := uow.AdminRepository().FindByID(ctx, *req.AgentID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrInvalidAgent(err)
+				}
+
+				return err
+			}
+
+			if a.Role != model.AdminRoleAgent || (a.IsActive != nil && !*a.IsActive) {
+				return ErrInvalidAgent(nil)
+			}
+		}
+
+		var maxPaymentTime *time.Time
+		if s.opt.PaymentTimeout > 0 {
+			deadline := time.Now().Add(s.opt.PaymentTimeout)
+			maxPaymentTime = &deadline
+		}
+
+		code, err := s.codeGenerator.Generate(time.Now())
+		if err != nil {
+			return err
+		}
+
+		date := req.Date
+		if req.DepartureBatchID != nil {
+			batch, err := departure.Reserve(ctx, uow, *req.DepartureBatchID, p.ID, req.TotalQty)
+			if err != nil {
+				return err
+			}
+			date = &batch.DepartureDate
+		}
+
+		subtotal := p.Price.Mul(decimal.NewFromInt(int64(req.TotalQty)))
+
+		var redemption *voucher.Redemption
+		discount := decimal.Zero
+		if req.VoucherCode != nil {
+			redemption, err = voucher.Redeem(ctx, uow, *req.VoucherCode, u.ID, p.ID, subtotal)
+			if err != nil {
+				return err
+			}
+			discount = redemption.Discount
+		}
+
+		booking := model.Booking{
+			Code:           code,
+			Date:           date,
+			ProductID:      &p.ID,
+			ProductName:    &p.Name,
+			UserID:         u.ID,
+			UserFullName:   u.FullName,
+			TotalQty:       req.TotalQty,
+			TotalAmount:    subtotal.Sub(discount),
+			Status:         model.BookingStatusBooked,
+			PaymentStatus:  model.PaymentStatusUnpaid,
+			MaxPaymentTime: maxPaymentTime,
+			CreatedBy:      actor.FromContext(ctx).JSON(),
+
+			DepartureBatchID: req.DepartureBatchID,
+			DiscountAmount:   discount,
+			AgentID:          req.AgentID,
+		}
+		if redemption != nil {
+			booking.VoucherID = &redemption.Voucher.ID
+		}
+
+		created, err = uow.BookingRepository().Save(ctx, &booking)
+		if err != nil {
+			// A code collision is returned as is so the caller can retry with a new code
+			if isCodeConflict(err) {
+				return err
+			}
+
+			// check db-specific error
+			if pgErr := dberror.GetError(err); pgErr != nil {
+				switch pgErr.Code {
+				case dberror.UniqueViolation:
+					msg, details := dberror.ParseUniqueConstraintError(pgErr)
+					return apperror.New(apperror.DuplicateEntry, msg, err, details)
+				}
+			}
+
+			return err
+		}
+
+		if redemption != nil {
+			err = redemption.Record(ctx, uow, created.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Record the initial status so the history covers the full lifecycle
+		_, err = uow.BookingStatusHistoryRepository().Save(ctx, &model.BookingStatusHistory{
+			CreatedBy: created.CreatedBy,
+			BookingID: created.ID,
+			ToStatus:  created.Status,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// isCodeConflict reports whether err is a unique violation on the booking code index.
+func isCodeConflict(err error) bool {
+	pgErr := dberror.GetError(err)
+	return pgErr != nil && pgErr.Code == dberror.UniqueViolation && pgErr.ConstraintName == codeConstraint
+}
+
+// qtyEditable reports whether the quantity, and with it the total amount, of a booking may still change.
+// Payments and installment dues are allocated against the total, so it is fixed once the booking has
+// received a payment or has a pending or approved installment plan.
+func qtyEditable(b *model.Booking) bool {
+	if b.TotalPayment.IsPositive() {
+		return false
+	}
+
+	return b.InstallmentRequestStatus == nil || *b.InstallmentRequestStatus == model.InstallmentRequestStatusRejected
+}
@@ -0,0 +1,106 @@
+package booking
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/departure"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+)
+
+// transitions defines the booking lifecycle as a state machine.
+// The happy path is booked → confirmed → on-trip → done; a booking can be canceled
+// before the trip starts and refunded once it is no longer going to travel.
+var transitions = map[model.BookingStatus][]model.BookingStatus{
+	model.BookingStatusBooked: {
+		model.BookingStatusConfirmed,
+		model.BookingStatusCanceled,
+		model.BookingStatusRefunded,
+	},
+	model.BookingStatusConfirmed: {
+		model.BookingStatusOnTrip,
+		model.BookingStatusCanceled,
+		model.BookingStatusRefunded,
+	},
+	model.BookingStatusOnTrip: {
+		model.BookingStatusDone,
+	},
+	model.BookingStatusCanceled: {
+		model.BookingStatusRefunded,
+	},
+}
+
+// holdsSeats reports whether a booking in the given status occupies seats in its departure batch.
+func holdsSeats(status model.BookingStatus) bool {
+	switch status {
+	case model.BookingStatusCanceled, model.BookingStatusRefunded:
+		return false
+	default:
+		return true
+	}
+}
+
+// CanTransition reports whether a booking may move from one status to another.
+func CanTransition(from, to model.BookingStatus) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// checkTransition validates a transition and returns the matching domain error when it is not allowed.
+func checkTransition(from, to model.BookingStatus) error {
+	if CanTransition(from, to) {
+		return nil
+	}
+
+	switch to {
+	case model.BookingStatusConfirmed:
+		if from == model.BookingStatusConfirmed || from == model.BookingStatusOnTrip || from == model.BookingStatusDone {
+			return ErrBookingAlreadyConfirmed(from)
+		}
+	case model.BookingStatusCanceled:
+		return ErrBookingNotCancellable(from)
+	}
+
+	return ErrInvalidTransition(from, to)
+}
+
+// Transition moves the booking to the target status and records the change in the status history.
+// The actor is taken from the context. It must be called within a transaction, ideally on a row
+// obtained through FindByIDForUpdate so concurrent transitions are serialized.
+func Transition(ctx context.Context, uow contract.UnitOfWork, booking *model.Booking, to model.BookingStatus, reason *string) error {
+	from := booking.Status
+	if err := checkTransition(from, to); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	by := actor.FromContext(ctx).JSON()
+
+	booking.Status = to
+	booking.ModifiedOn = &now
+	booking.ModifiedBy = by
+
+	_, err := uow.BookingRepository().Update(ctx, booking)
+	if err != nil {
+		return err
+	}
+
+	// Seats go back to the departure batch once the booking will no longer travel
+	if booking.DepartureBatchID != nil && holdsSeats(from) && !holdsSeats(to) {
+		err = departure.Release(ctx, uow, *booking.DepartureBatchID, booking.TotalQty)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = uow.BookingStatusHistoryRepository().Save(ctx, &model.BookingStatusHistory{
+		CreatedBy:  by,
+		BookingID:  booking.ID,
+		FromStatus: &from,
+		ToStatus:   to,
+		Reason:     reason,
+	})
+	return err
+}
@@ -0,0 +1,180 @@
+package booking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/app/apptest"
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+func TestCanTransition(t *testing.T) {
+	statuses := []model.BookingStatus{
+		model.BookingStatusBooked,
+		model.BookingStatusConfirmed,
+		model.BookingStatusOnTrip,
+		model.BookingStatusDone,
+		model.BookingStatusCanceled,
+		model.BookingStatusRefunded,
+	}
+
+	allowed := map[[2]model.BookingStatus]bool{
+		{model.BookingStatusBooked, model.BookingStatusConfirmed}:   true,
+		{model.BookingStatusBooked, model.BookingStatusCanceled}:    true,
+		{model.BookingStatusBooked, model.BookingStatusRefunded}:    true,
+		{model.BookingStatusConfirmed, model.BookingStatusOnTrip}:   true,
+		{model.BookingStatusConfirmed, model.BookingStatusCanceled}: true,
+		{model.BookingStatusConfirmed, model.BookingStatusRefunded}: true,
+		{model.BookingStatusOnTrip, model.BookingStatusDone}:        true,
+		{model.BookingStatusCanceled, model.BookingStatusRefunded}:  true,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := allowed[[2]model.BookingStatus{from, to}]
+			if got := CanTransition(from, to); got != want {
+				t.Errorf("CanTransition(%s, %s) = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		name string
+		from model.BookingStatus
+		to   model.BookingStatus
+		want apperror.Code
+	}{
+		{"confirm booked", model.BookingStatusBooked, model.BookingStatusConfirmed, ""},
+		{"confirm twice", model.BookingStatusConfirmed, model.BookingStatusConfirmed, apperror.BookingAlreadyConfirmed},
+		{"confirm on trip", model.BookingStatusOnTrip, model.BookingStatusConfirmed, apperror.BookingAlreadyConfirmed},
+		{"confirm done", model.BookingStatusDone, model.BookingStatusConfirmed, apperror.BookingAlreadyConfirmed},
+		{"confirm canceled", model.BookingStatusCanceled, model.BookingStatusConfirmed, apperror.StateConflict},
+		{"cancel on trip", model.BookingStatusOnTrip, model.BookingStatusCanceled, apperror.BookingNotCancellable},
+		{"cancel done", model.BookingStatusDone, model.BookingStatusCanceled, apperror.BookingNotCancellable},
+		{"cancel twice", model.BookingStatusCanceled, model.BookingStatusCanceled, apperror.BookingNotCancellable},
+		{"start trip from booked", model.BookingStatusBooked, model.BookingStatusOnTrip, apperror.StateConflict},
+		{"complete confirmed", model.BookingStatusConfirmed, model.BookingStatusDone, apperror.StateConflict},
+		{"reopen refunded", model.BookingStatusRefunded, model.BookingStatusBooked, apperror.StateConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTransition(tt.from, tt.to)
+			apptest.AssertCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestTransition(t *testing.T) {
+	batchID := uint(5)
+
+	tests := []struct {
+		name          string
+		from          model.BookingStatus
+		to            model.BookingStatus
+		batchID       *uint
+		releasedSeats int
+	}{
+		{"confirm keeps seats", model.BookingStatusBooked, model.BookingStatusConfirmed, &batchID, 0},
+		{"cancel releases seats", model.BookingStatusConfirmed, model.BookingStatusCanceled, &batchID, 3},
+		{"refund after cancel releases nothing", model.BookingStatusCanceled, model.BookingStatusRefunded, &batchID, 0},
+		{"cancel without batch", model.BookingStatusBooked, model.BookingStatusCanceled, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uow := newFakeUoW()
+			ctx := actor.NewContext(context.Background(), actor.Actor{ID: 1, UID: "admin-uid", Name: "Admin", Role: model.AdminRoleAdmin})
+			reason := "test"
+			b := &model.Booking{ID: 10, Status: tt.from, TotalQty: 3, DepartureBatchID: tt.batchID}
+
+			err := Transition(ctx, uow, b, tt.to, &reason)
+			if err != nil {
+				t.Fatalf("Transition() error = %v", err)
+			}
+
+			if b.Status != tt.to || len(uow.bookings.updated) != 1 || uow.bookings.updated[0].Status != tt.to {
+				t.Errorf("booking not updated to %s: status %s, updates %d", tt.to, b.Status, len(uow.bookings.updated))
+			}
+			if uow.batches.released != tt.releasedSeats {
+				t.Errorf("released seats = %d, want %d", uow.batches.released, tt.releasedSeats)
+			}
+
+			if len(uow.histories.saved) != 1 {
+				t.Fatalf("saved histories = %d, want 1", len(uow.histories.saved))
+			}
+			h := uow.histories.saved[0]
+			if h.FromStatus == nil || *h.FromStatus != tt.from || h.ToStatus != tt.to || h.Reason != &reason {
+				t.Errorf("history = %+v, want %s -> %s", h, tt.from, tt.to)
+			}
+			if string(h.CreatedBy) != `{"user_uid":"admin-uid","user_name":"Admin"}` {
+				t.Errorf("history created by %s, want the actor", h.CreatedBy)
+			}
+		})
+	}
+}
+
+func TestTransitionRejected(t *testing.T) {
+	uow := newFakeUoW()
+	b := &model.Booking{ID: 10, Status: model.BookingStatusDone}
+
+	err := Transition(context.Background(), uow, b, model.BookingStatusCanceled, nil)
+	if err == nil {
+		t.Fatal("Transition() error = nil, want an error")
+	}
+	if b.Status != model.BookingStatusDone || len(uow.bookings.updated) != 0 || len(uow.histories.saved) != 0 {
+		t.Error("rejected transition changed the booking or recorded history")
+	}
+}
+
+type fakeUoW struct {
+	*apptest.UnitOfWork
+	bookings  *fakeBookingRepository
+	histories *fakeHistoryRepository
+	batches   *fakeBatchRepository
+}
+
+func newFakeUoW() *fakeUoW {
+	u := &fakeUoW{
+		bookings:  &fakeBookingRepository{},
+		histories: &fakeHistoryRepository{},
+		batches:   &fakeBatchRepository{},
+	}
+	u.UnitOfWork = &apptest.UnitOfWork{Bookings: u.bookings, BookingStatusHistories: u.histories, DepartureBatches: u.batches}
+	return u
+}
+
+type fakeBookingRepository struct {
+	contract.BookingRepository
+	updated []model.Booking
+}
+
+func (r *fakeBookingRepository) Update(_ context.Context, b *model.Booking) (*model.Booking, error) {
+	r.updated = append(r.updated, *b)
+	return b, nil
+}
+
+type fakeHistoryRepository struct {
+	contract.BookingStatusHistoryRepository
+	saved []model.BookingStatusHistory
+}
+
+func (r *fakeHistoryRepository) Save(_ context.Context, h *model.BookingStatusHistory) (*model.BookingStatusHistory, error) {
+	r.saved = append(r.saved, *h)
+	return h, nil
+}
+
+type fakeBatchRepository struct {
+	contract.DepartureBatchRepository
+	released int
+}
+
+func (r *fakeBatchRepository) AdjustSeatsTaken(_ context.Context, _ uint, delta int) (bool, error) {
+	r.released -= delta
+	return true, nil
+}
@@ -0,0 +1,88 @@
+package departure
+
+import "github.com/aburizalpurnama/travel/internal/pkg/apperror"
+
+// ==========================================================
+// Departure Batch Error Constructors
+// ==========================================================
+
+// ErrDepartureBatchNotFound creates a new error for missing departure batch records.
+func ErrDepartureBatchNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"departure batch not found",
+		err,
+		nil,
+	)
+}
+
+// ErrDepartureBatchSoldOut creates a new error for reservations exceeding the seats left in a batch.
+func ErrDepartureBatchSoldOut(available int) *apperror.AppError {
+	return apperror.New(
+		apperror.BookingBatchSoldOut,
+		"departure batch does not have enough seats left",
+		nil,
+		map[string]any{"seats_available": available},
+	)
+}
+
+// ErrDepartureBatchNotBookable creates a new error for reservations on a batch that is inactive,
+// already departed or belongs to another product.
+func ErrDepartureBatchNotBookable() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"departure batch is not available for this product",
+		nil,
+		map[string]any{"departure_batch_id": apperror.InvalidValue},
+	)
+}
+
+// ErrInvalidDates creates a new error for batches returning before they depart.
+func ErrInvalidDates(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		err,
+		map[string]any{"return_date": apperror.InvalidValue},
+	)
+}
+
+// ErrQuotaBelowSeatsTaken creates a new error for reducing a batch quota below the seats already taken.
+func ErrQuotaBelowSeatsTaken(seatsTaken int) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"quota cannot be lower than the seats already taken",
+		nil,
+		map[string]any{"quota": apperror.ValueTooLow, "seats_taken": seatsTaken},
+	)
+}
+
+// ErrDepartureBatchInUse creates a new error for deleting a batch that still has seats taken.
+func ErrDepartureBatchInUse(seatsTaken int) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"departure batch still has seats taken",
+		nil,
+		map[string]any{"seats_taken": seatsTaken},
+	)
+}
+
+// ErrDepartureBatchDatesFixed creates a new error for changing the dates of a batch that already has seats taken.
+func ErrDepartureBatchDatesFixed(seatsTaken int) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"departure batch dates cannot be changed once seats are taken, reschedule its bookings instead",
+		nil,
+		map[string]any{"seats_taken": seatsTaken},
+	)
+}
+
+// ErrMuthawifScheduleConflict creates a new error for moving a batch onto dates an assigned muthawif is already booked for.
+func ErrMuthawifScheduleConflict(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"an assigned muthawif has another trip overlapping the new dates",
+		err,
+		nil,
+	)
+}
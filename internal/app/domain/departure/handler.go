@@ -0,0 +1,212 @@
+package departure
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("departure.handler")
+
+type Handler struct {
+	service contract.DepartureBatchService
+}
+
+// NewHandler initializes a new instance of DepartureBatchHandler.
+func NewHandler(service contract.DepartureBatchService) *Handler {
+	return &Handler{service: service}
+}
+
+// CreateDepartureBatch handles scheduling a new departure batch for a product.
+func (h *Handler) CreateDepartureBatch(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "CreateDepartureBatch")
+	defer span.End()
+
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.DepartureBatchCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	batch, err := h.service.CreateDepartureBatch(ctx, uint(productID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(batch, nil))
+}
+
+// GetProductDepartureBatches retrieves the departure batches of a product with pagination and filtering.
+func (h *Handler) GetProductDepartureBatches(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetProductDepartureBatches")
+	defer span.End()
+
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	req := payload.DepartureBatchGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	batches, pagination, err := h.service.GetProductDepartureBatches(ctx, uint(productID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(batches, pagination))
+}
+
+// GetDepartureBatch retrieves a single departure batch by its ID.
+func (h *Handler) GetDepartureBatch(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetDepartureBatch")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	batch, err := h.service.GetDepartureBatchByID(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(batch, nil))
+}
+
+// UpdateDepartureBatch handles updates to an existing departure batch.
+func (h *Handler) UpdateDepartureBatch(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "UpdateDepartureBatch")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.DepartureBatchUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	batch, err := h.service.UpdateDepartureBatch(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(batch, nil))
+}
+
+// DeleteDepartureBatch removes a departure batch by its ID.
+func (h *Handler) DeleteDepartureBatch(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "DeleteDepartureBatch")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	if err := h.service.DeleteDepartureBatch(ctx, uint(id)); err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success("success delete data", nil))
+}
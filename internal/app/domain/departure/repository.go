@@ -0,0 +1,54 @@
+package departure
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("departure.repository")
+
+// Repository implements the contract.DepartureBatchRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.DepartureBatch, model.DepartureBatchFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new departure batch repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.DepartureBatch, model.DepartureBatchFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.DepartureBatchRepository = (*Repository)(nil)
+
+// AdjustSeatsTaken atomically adds delta to the seats taken of a batch with a conditional update,
+// so the seat count can never leave the [0, quota] range regardless of concurrent writers.
+// It reports whether the row was updated.
+func (r *Repository) AdjustSeatsTaken(ctx context.Context, id uint, delta int) (bool, error) {
+	ctx, span := repositoryTracer.Start(ctx, "AdjustSeatsTaken")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.DepartureBatch{}).
+		Where("id = ? AND deleted_on IS NULL AND seats_taken + ? BETWEEN 0 AND quota", id, delta).
+		Updates(map[string]any{
+			"seats_taken": gorm.Expr("seats_taken + ?", delta),
+			"modified_on": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,35 @@
+package departure
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/app/apptest"
+)
+
+// TestAdjustSeatsTaken checks the statement behind seat reservations. Concurrent reservations are kept
+// within the quota by the database evaluating the guard on the row it updates, so the guard must be
+// part of the UPDATE itself rather than checked against a previously read batch.
+func TestAdjustSeatsTaken(t *testing.T) {
+	db := apptest.DryRunDB(t)
+	statements := apptest.CaptureUpdates(t, db)
+
+	_, err := NewRepository(db).AdjustSeatsTaken(context.Background(), 7, 3)
+	if err != nil {
+		t.Fatalf("AdjustSeatsTaken() error = %v", err)
+	}
+
+	if len(*statements) != 1 {
+		t.Fatalf("statements = %v, want a single UPDATE", *statements)
+	}
+	for _, want := range []string{
+		`UPDATE "core"."departure_batches" SET`,
+		`"seats_taken"=seats_taken + 3`,
+		`WHERE (id = 7 AND deleted_on IS NULL AND seats_taken + 3 BETWEEN 0 AND quota)`,
+	} {
+		if !strings.Contains((*statements)[0], want) {
+			t.Errorf("statement = %s, want it to contain %s", (*statements)[0], want)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package departure
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers departure batch routes to the provided router group.
+// Creating, updating and deleting departure batches requires the departure:write permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	router.Post("/products/:id/departure-batches", authz.Require(rbac.DepartureWrite), handler.CreateDepartureBatch)
+	router.Get("/products/:id/departure-batches", handler.GetProductDepartureBatches)
+
+	batches := router.Group("/departure-batches")
+	batches.Get("/:id", handler.GetDepartureBatch)
+	batches.Patch("/:id", authz.Require(rbac.DepartureWrite), handler.UpdateDepartureBatch)
+	batches.Delete("/:id", authz.Require(rbac.DepartureWrite), handler.DeleteDepartureBatch)
+}
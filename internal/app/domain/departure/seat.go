@@ -0,0 +1,115 @@
+package departure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"gorm.io/gorm"
+)
+
+// Reserve takes qty seats from a departure batch of the given product and returns the locked batch.
+// The batch row is locked for the rest of the transaction and the seat count is incremented with
+// a conditional update, so concurrent reservations can never oversell it. It must be called within a transaction.
+func Reserve(ctx context.Context, uow contract.UnitOfWork, batchID, productID uint, qty int) (*model.DepartureBatch, error) {
+	batch, err := uow.DepartureBatchRepository().FindByIDForUpdate(ctx, batchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDepartureBatchNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = checkReservable(batch, productID, qty)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := uow.DepartureBatchRepository().AdjustSeatsTaken(ctx, batch.ID, qty)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrDepartureBatchSoldOut(batch.SeatsAvailable())
+	}
+
+	batch.SeatsTaken += qty
+	return batch, nil
+}
+
+// CheckAvailability reports through its error whether qty seats can currently be reserved on a departure
+// batch of the given product, without reserving them. It returns the batch for quoting purposes.
+func CheckAvailability(ctx context.Context, uow contract.UnitOfWork, batchID, productID uint, qty int) (*model.DepartureBatch, error) {
+	batch, err := uow.DepartureBatchRepository().FindByID(ctx, batchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDepartureBatchNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = checkReservable(batch, productID, qty)
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// Release returns qty seats to a departure batch. It must be called within a transaction.
+func Release(ctx context.Context, uow contract.UnitOfWork, batchID uint, qty int) error {
+	ok, err := uow.DepartureBatchRepository().AdjustSeatsTaken(ctx, batchID, -qty)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("departure batch %d: cannot release %d seats", batchID, qty)
+	}
+
+	return nil
+}
+
+// Resize changes the seats held in a departure batch by delta, reserving or releasing as needed.
+// It must be called within a transaction.
+func Resize(ctx context.Context, uow contract.UnitOfWork, batchID, productID uint, delta int) error {
+	switch {
+	case delta > 0:
+		_, err := Reserve(ctx, uow, batchID, productID, delta)
+		return err
+	case delta < 0:
+		return Release(ctx, uow, batchID, -delta)
+	default:
+		return nil
+	}
+}
+
+// checkReservable validates that qty seats can be reserved on the batch for the given product.
+func checkReservable(batch *model.DepartureBatch, productID uint, qty int) error {
+	if !isBookable(batch, productID, time.Now()) {
+		return ErrDepartureBatchNotBookable()
+	}
+
+	if batch.SeatsAvailable() < qty {
+		return ErrDepartureBatchSoldOut(batch.SeatsAvailable())
+	}
+
+	return nil
+}
+
+// isBookable reports whether seats can be reserved on the batch for the given product at 'now'.
+func isBookable(batch *model.DepartureBatch, productID uint, now time.Time) bool {
+	if batch.ProductID != productID {
+		return false
+	}
+	if batch.IsActive != nil && !*batch.IsActive {
+		return false
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return batch.DepartureDate.After(today)
+}
@@ -0,0 +1,134 @@
+package departure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/apptest"
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+func TestIsBookable(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+	inactive := false
+
+	tests := []struct {
+		name  string
+		batch model.DepartureBatch
+		want  bool
+	}{
+		{"future departure", model.DepartureBatch{ProductID: 1, DepartureDate: now.AddDate(0, 0, 30)}, true},
+		{"departs tomorrow", model.DepartureBatch{ProductID: 1, DepartureDate: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)}, true},
+		{"departs today", model.DepartureBatch{ProductID: 1, DepartureDate: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)}, false},
+		{"departed", model.DepartureBatch{ProductID: 1, DepartureDate: now.AddDate(0, 0, -1)}, false},
+		{"other product", model.DepartureBatch{ProductID: 2, DepartureDate: now.AddDate(0, 0, 30)}, false},
+		{"inactive", model.DepartureBatch{ProductID: 1, DepartureDate: now.AddDate(0, 0, 30), IsActive: &inactive}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isBookable(&tt.batch, 1, now); got != tt.want {
+				t.Errorf("isBookable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReserve(t *testing.T) {
+	tests := []struct {
+		name      string
+		quota     int
+		taken     int
+		qty       int
+		wantCode  apperror.Code
+		wantTaken int
+	}{
+		{"seats available", 10, 4, 3, "", 7},
+		{"last seats", 10, 7, 3, "", 10},
+		{"not enough seats", 10, 8, 3, apperror.BookingBatchSoldOut, 8},
+		{"sold out", 10, 10, 1, apperror.BookingBatchSoldOut, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeBatchRepository(model.DepartureBatch{ID: 1, ProductID: 1, DepartureDate: time.Now().AddDate(0, 1, 0), Quota: tt.quota, SeatsTaken: tt.taken})
+
+			batch, err := Reserve(context.Background(), &apptest.UnitOfWork{DepartureBatches: repo}, 1, 1, tt.qty)
+			apptest.AssertCode(t, err, tt.wantCode)
+			if err == nil && batch.SeatsTaken != tt.wantTaken {
+				t.Errorf("returned batch has %d seats taken, want %d", batch.SeatsTaken, tt.wantTaken)
+			}
+			if repo.taken != tt.wantTaken {
+				t.Errorf("seats taken = %d, want %d", repo.taken, tt.wantTaken)
+			}
+		})
+	}
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name      string
+		taken     int
+		delta     int
+		wantCode  apperror.Code
+		wantTaken int
+	}{
+		{"grow", 4, 2, "", 6},
+		{"grow beyond quota", 9, 2, apperror.BookingBatchSoldOut, 9},
+		{"shrink", 4, -3, "", 1},
+		{"unchanged", 4, 0, "", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeBatchRepository(model.DepartureBatch{ID: 1, ProductID: 1, DepartureDate: time.Now().AddDate(0, 1, 0), Quota: 10, SeatsTaken: tt.taken})
+
+			err := Resize(context.Background(), &apptest.UnitOfWork{DepartureBatches: repo}, 1, 1, tt.delta)
+			apptest.AssertCode(t, err, tt.wantCode)
+			if repo.taken != tt.wantTaken {
+				t.Errorf("seats taken = %d, want %d", repo.taken, tt.wantTaken)
+			}
+		})
+	}
+}
+
+func TestReleaseBelowZero(t *testing.T) {
+	repo := newFakeBatchRepository(model.DepartureBatch{ID: 1, ProductID: 1, Quota: 10, SeatsTaken: 2})
+
+	err := Release(context.Background(), &apptest.UnitOfWork{DepartureBatches: repo}, 1, 3)
+	if err == nil {
+		t.Fatal("Release() error = nil, want an error")
+	}
+	if repo.taken != 2 {
+		t.Errorf("seats taken = %d, want 2", repo.taken)
+	}
+}
+
+// fakeBatchRepository holds a single batch and applies seat adjustments with the same guard as the
+// conditional update of the real repository, which TestAdjustSeatsTaken covers.
+type fakeBatchRepository struct {
+	contract.DepartureBatchRepository
+	batch model.DepartureBatch
+	taken int
+}
+
+func newFakeBatchRepository(batch model.DepartureBatch) *fakeBatchRepository {
+	return &fakeBatchRepository{batch: batch, taken: batch.SeatsTaken}
+}
+
+func (r *fakeBatchRepository) FindByIDForUpdate(_ context.Context, _ uint) (*model.DepartureBatch, error) {
+	batch := r.batch
+	batch.SeatsTaken = r.taken
+	return &batch, nil
+}
+
+func (r *fakeBatchRepository) AdjustSeatsTaken(_ context.Context, _ uint, delta int) (bool, error) {
+	if r.taken+delta < 0 || r.taken+delta > r.batch.Quota {
+		return false, nil
+	}
+	r.taken += delta
+	return true, nil
+}
@@ -0,0 +1,269 @@
+package departure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/product"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("departure.service")
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+}
+
+// NewService initializes a new instance of departure batch service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper) *service {
+	return &service{uow: uow, mapper: mapper}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.DepartureBatchService = (*service)(nil)
+
+// CreateDepartureBatch schedules a new departure batch for a product.
+func (s *service) CreateDepartureBatch(ctx context.Context, productID uint, req payload.DepartureBatchCreateRequest) (*payload.DepartureBatchBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CreateDepartureBatch")
+	defer span.End()
+
+	departureDate, returnDate, err := parseDates(req.DepartureDate, req.ReturnDate)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.uow.ProductRepository().FindByID(ctx, productID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, product.ErrProductNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	created, err := s.uow.DepartureBatchRepository().Save(ctx, &model.DepartureBatch{
+		ProductID:     productID,
+		DepartureDate: departureDate,
+		ReturnDate:    returnDate,
+		Quota:         req.Quota,
+		IsActive:      req.IsActive,
+		CreatedBy:     actor.FromContext(ctx).JSON(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(created)
+}
+
+// GetProductDepartureBatches retrieves the departure batches of a product with support for pagination and filtering.
+func (s *service) GetProductDepartureBatches(ctx context.Context, productID uint, req payload.DepartureBatchGetAllRequest) ([]payload.DepartureBatchBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetProductDepartureBatches")
+	defer span.End()
+
+	_, err := s.uow.ProductRepository().FindByID(ctx, productID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, product.ErrProductNotFound(err)
+		}
+
+		return nil, nil, err
+	}
+
+	if req.DepartureBatchFilter == nil {
+		req.DepartureBatchFilter = &model.DepartureBatchFilter{}
+	}
+	req.ProductID = &productID
+
+	var count int64
+	var batches []model.DepartureBatch
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.DepartureBatchRepository().Count(groupCtx, req.DepartureBatchFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		batches, err = s.uow.DepartureBatchRepository().FindAll(groupCtx, req.Page, req.Size, req.DepartureBatchFilter)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp []payload.DepartureBatchBaseResponse
+	err = s.mapper.ToResponse(batches, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetDepartureBatchByID retrieves a specific departure batch by its unique identifier.
+func (s *service) GetDepartureBatchByID(ctx context.Context, id uint) (*payload.DepartureBatchBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetDepartureBatchByID")
+	defer span.End()
+
+	batch, err := s.uow.DepartureBatchRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDepartureBatchNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toResponse(batch)
+}
+
+// UpdateDepartureBatch modifies an existing departure batch.
+// The batch is locked so the quota cannot be reduced below seats reserved concurrently.
+// Date changes are carried over to the muthawif assigned to the batch. They are rejected once seats
+// are taken, as the bookings on the batch were priced and passport-checked against the old dates;
+// those bookings move through a reschedule instead.
+func (s *service) UpdateDepartureBatch(ctx context.Context, id uint, req payload.DepartureBatchUpdateRequest) (*payload.DepartureBatchBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "UpdateDepartureBatch")
+	defer span.End()
+
+	var updated *model.DepartureBatch
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		batch, err := uow.DepartureBatchRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrDepartureBatchNotFound(err)
+			}
+
+			return err
+		}
+
+		departureDate := batch.DepartureDate.Format(time.DateOnly)
+		if req.DepartureDate != nil {
+			departureDate = *req.DepartureDate
+		}
+		returnDate := batch.ReturnDate.Format(time.DateOnly)
+		if req.ReturnDate != nil {
+			returnDate = *req.ReturnDate
+		}
+
+		datesChanged := departureDate != batch.DepartureDate.Format(time.DateOnly) || returnDate != batch.ReturnDate.Format(time.DateOnly)
+		if datesChanged && batch.SeatsTaken > 0 {
+			return ErrDepartureBatchDatesFixed(batch.SeatsTaken)
+		}
+
+		batch.DepartureDate, batch.ReturnDate, err = parseDates(departureDate, returnDate)
+		if err != nil {
+			return err
+		}
+
+		if req.Quota != nil {
+			if *req.Quota < batch.SeatsTaken {
+				return ErrQuotaBelowSeatsTaken(batch.SeatsTaken)
+			}
+			batch.Quota = *req.Quota
+		}
+
+		if req.IsActive != nil {
+			batch.IsActive = req.IsActive
+		}
+
+		now := time.Now()
+		batch.ModifiedOn = &now
+		batch.ModifiedBy = actor.FromContext(ctx).JSON()
+
+		updated, err = uow.DepartureBatchRepository().Update(ctx, batch)
+		if err != nil {
+			return err
+		}
+
+		// Assigned muthawif follow the new dates, which must not overlap their other trips
+		err = uow.MuthawifAssignmentRepository().SyncBatchDates(ctx, batch.ID, batch.DepartureDate, batch.ReturnDate)
+		if dberror.GetSQLState(err) == dberror.ExclusionViolation {
+			return ErrMuthawifScheduleConflict(err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(updated)
+}
+
+// DeleteDepartureBatch removes a departure batch record from the database.
+// Batches with seats taken must be emptied first by canceling or moving their bookings.
+// Muthawif assignments on the batch are removed with it.
+func (s *service) DeleteDepartureBatch(ctx context.Context, id uint) error {
+	ctx, span := serviceTracer.Start(ctx, "DeleteDepartureBatch")
+	defer span.End()
+
+	return s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		batch, err := uow.DepartureBatchRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrDepartureBatchNotFound(err)
+			}
+
+			return err
+		}
+
+		if batch.SeatsTaken > 0 {
+			return ErrDepartureBatchInUse(batch.SeatsTaken)
+		}
+
+		err = uow.MuthawifAssignmentRepository().DeleteByDepartureBatchID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return uow.DepartureBatchRepository().Delete(ctx, id)
+	})
+}
+
+// toResponse maps a departure batch to the response DTO.
+func (s *service) toResponse(batch *model.DepartureBatch) (*payload.DepartureBatchBaseResponse, error) {
+	var resp payload.DepartureBatchBaseResponse
+	err := s.mapper.ToResponse(batch, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// parseDates parses the departure and return dates of a batch and checks that it does not return before it departs.
+func parseDates(departure, ret string) (time.Time, time.Time, error) {
+	departureDate, err := time.Parse(time.DateOnly, departure)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidDates(err)
+	}
+
+	returnDate, err := time.Parse(time.DateOnly, ret)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidDates(err)
+	}
+
+	if returnDate.Before(departureDate) {
+		return time.Time{}, time.Time{}, ErrInvalidDates(nil)
+	}
+
+	return departureDate, returnDate, nil
+}
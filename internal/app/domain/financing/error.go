@@ -0,0 +1,62 @@
+package financing
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Financing Error Constructors
+// ==========================================================
+
+// ErrFinancingRequestNotFound creates a new error for missing financing request records.
+// Plans that are not routed to a financing institution are reported as missing as well.
+func ErrFinancingRequestNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"financing request not found",
+		err,
+		nil,
+	)
+}
+
+// ErrInstitutionNotFound creates a new error for missing financing institution records.
+func ErrInstitutionNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"financing institution not found",
+		err,
+		nil,
+	)
+}
+
+// ErrFinancingForbidden creates a new error for accessing the financing requests of another institution.
+func ErrFinancingForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"financing institutions can only access their own requests",
+		nil,
+		nil,
+	)
+}
+
+// ErrFinancingRequestNotPending creates a new error for deciding on a request that is no longer awaiting a decision.
+func ErrFinancingRequestNotPending(status model.InstallmentRequestStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"financing request is not awaiting a decision",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrFinancingNotDisbursable creates a new error for reporting a disbursement on a request that is not approved
+// or has already been disbursed.
+func ErrFinancingNotDisbursable(status model.InstallmentRequestStatus, disbursed bool) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"financing request cannot be disbursed in its current state",
+		nil,
+		map[string]any{"status": status, "disbursed": disbursed},
+	)
+}
@@ -0,0 +1,291 @@
+package financing
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("financing.handler")
+
+type Handler struct {
+	service contract.FinancingService
+}
+
+// NewHandler initializes a new instance of FinancingHandler.
+func NewHandler(service contract.FinancingService) *Handler {
+	return &Handler{service: service}
+}
+
+// CreateFinancingInstitution handles the registration of a financing institution.
+func (h *Handler) CreateFinancingInstitution(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "CreateFinancingInstitution")
+	defer span.End()
+
+	var req payload.FinancingInstitutionCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	institution, err := h.service.CreateFinancingInstitution(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(institution, nil))
+}
+
+// GetFinancingInstitutions retrieves a list of financing institutions with pagination and filtering.
+func (h *Handler) GetFinancingInstitutions(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetFinancingInstitutions")
+	defer span.End()
+
+	req := payload.FinancingInstitutionGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	institutions, pagination, err := h.service.GetAllFinancingInstitutions(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(institutions, pagination))
+}
+
+// GetInstitutionRequests retrieves the financing requests routed to an institution with pagination and filtering.
+func (h *Handler) GetInstitutionRequests(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetInstitutionRequests")
+	defer span.End()
+
+	institutionID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	req := payload.FinancingRequestGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	plans, pagination, err := h.service.GetInstitutionRequests(ctx, uint(institutionID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(plans, pagination))
+}
+
+// GetFinancingRequest retrieves a single financing request by its ID.
+func (h *Handler) GetFinancingRequest(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetFinancingRequest")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	plan, err := h.service.GetFinancingRequest(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(plan, nil))
+}
+
+// ApproveFinancingRequest handles the approval of a financing request under the institution's terms.
+func (h *Handler) ApproveFinancingRequest(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "ApproveFinancingRequest")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.FinancingApproveRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	plan, err := h.service.ApproveFinancingRequest(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(plan, nil))
+}
+
+// RejectFinancingRequest handles the rejection of a financing request.
+func (h *Handler) RejectFinancingRequest(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "RejectFinancingRequest")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.FinancingRejectRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	plan, err := h.service.RejectFinancingRequest(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(plan, nil))
+}
+
+// RecordDisbursement handles the report of a disbursement for an approved financing request.
+func (h *Handler) RecordDisbursement(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "RecordDisbursement")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.FinancingDisbursementCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	disbursement, err := h.service.RecordDisbursement(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(disbursement, nil))
+}
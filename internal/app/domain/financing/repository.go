@@ -0,0 +1,24 @@
+package financing
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"gorm.io/gorm"
+)
+
+// InstitutionRepository implements the contract.FinancingInstitutionRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type InstitutionRepository struct {
+	*repository.GORM[model.FinancingInstitution, model.FinancingInstitutionFilter]
+}
+
+// NewInstitutionRepository creates a new financing institution repository instance.
+func NewInstitutionRepository(db *gorm.DB) *InstitutionRepository {
+	return &InstitutionRepository{
+		GORM: repository.NewGORM[model.FinancingInstitution, model.FinancingInstitutionFilter](db),
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.FinancingInstitutionRepository = (*InstitutionRepository)(nil)
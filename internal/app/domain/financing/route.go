@@ -0,0 +1,22 @@
+package financing
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers the partner-facing financing routes to the provided router group.
+// Registering a financing institution requires the admin:manage permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	institutions := router.Group("/financing-institutions")
+	institutions.Get("/", handler.GetFinancingInstitutions)
+	institutions.Post("/", authz.Require(rbac.AdminManage), handler.CreateFinancingInstitution)
+	institutions.Get("/:id/requests", handler.GetInstitutionRequests)
+
+	requests := router.Group("/financing-requests")
+	requests.Get("/:id", handler.GetFinancingRequest)
+	requests.Post("/:id/approve", handler.ApproveFinancingRequest)
+	requests.Post("/:id/reject", handler.RejectFinancingRequest)
+	requests.Post("/:id/disbursements", handler.RecordDisbursement)
+}
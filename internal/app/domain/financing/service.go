@@ -0,0 +1,450 @@
+package financing
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/domain/installment"
+	"github.com/aburizalpurnama/travel/internal/app/domain/payment"
+	"github.com/aburizalpurnama/travel/internal/app/domain/referral"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("financing.service")
+
+type service struct {
+	uow            contract.UnitOfWork
+	mapper         contract.Mapper
+	rule           payment.DownPaymentRule
+	referralRule   referral.RewardRule
+	paymentTimeout time.Duration
+}
+
+// NewService initializes a new instance of financing service.
+// paymentTimeout is the time a booking has to receive a payment once its financing request is rejected.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, rule payment.DownPaymentRule, referralRule referral.RewardRule, paymentTimeout time.Duration) *service {
+	return &service{uow: uow, mapper: mapper, rule: rule, referralRule: referralRule, paymentTimeout: paymentTimeout}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.FinancingService = (*service)(nil)
+
+// CreateFinancingInstitution registers a financing institution. Its users are fin_inst admins assigned to it.
+func (s *service) CreateFinancingInstitution(ctx context.Context, req payload.FinancingInstitutionCreateRequest) (*payload.FinancingInstitutionResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CreateFinancingInstitution")
+	defer span.End()
+
+	created, err := s.uow.FinancingInstitutionRepository().Save(ctx, &model.FinancingInstitution{
+		Name:      strings.TrimSpace(req.Name),
+		CreatedBy: actor.FromContext(ctx).JSON(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toInstitutionResponse(created)
+}
+
+// GetAllFinancingInstitutions retrieves a list of financing institutions with support for pagination and filtering.
+func (s *service) GetAllFinancingInstitutions(ctx context.Context, req payload.FinancingInstitutionGetAllRequest) ([]payload.FinancingInstitutionResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllFinancingInstitutions")
+	defer span.End()
+
+	if req.FinancingInstitutionFilter == nil {
+		req.FinancingInstitutionFilter = &model.FinancingInstitutionFilter{}
+	}
+
+	var count int64
+	var institutions []model.FinancingInstitution
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.FinancingInstitutionRepository().Count(groupCtx, req.FinancingInstitutionFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		institutions, err = s.uow.FinancingInstitutionRepository().FindAll(groupCtx, req.Page, req.Size, req.FinancingInstitutionFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := make([]payload.FinancingInstitutionResponse, 0, len(institutions))
+	for i := range institutions {
+		r, err := s.toInstitutionResponse(&institutions[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		resp = append(resp, *r)
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetInstitutionRequests retrieves the financing requests routed to an institution with support for pagination and filtering.
+// Institution users can only list the requests of their own institution.
+func (s *service) GetInstitutionRequests(ctx context.Context, institutionID uint, req payload.FinancingRequestGetAllRequest) ([]payload.InstallmentPlanResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetInstitutionRequests")
+	defer span.End()
+
+	err := authorize(ctx, s.uow, institutionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = s.uow.FinancingInstitutionRepository().FindByID(ctx, institutionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrInstitutionNotFound(err)
+		}
+
+		return nil, nil, err
+	}
+
+	if req.InstallmentPlanFilter == nil {
+		req.InstallmentPlanFilter = &model.InstallmentPlanFilter{}
+	}
+	req.InstallmentPlanFilter.FinancingInstitutionID = &institutionID
+
+	var count int64
+	var plans []model.InstallmentPlan
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.InstallmentPlanRepository().Count(groupCtx, req.InstallmentPlanFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		plans, err = s.uow.InstallmentPlanRepository().FindAll(groupCtx, req.Page, req.Size, req.InstallmentPlanFilter)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := make([]payload.InstallmentPlanResponse, 0, len(plans))
+	for i := range plans {
+		plans[i].Dues = installment.BuildSchedule(&plans[i])
+
+		r, err := s.toPlanResponse(&plans[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		resp = append(resp, *r)
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetFinancingRequest retrieves a single financing request by its ID, with its proposed repayment schedule as dues.
+func (s *service) GetFinancingRequest(ctx context.Context, id uint) (*payload.InstallmentPlanResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetFinancingRequest")
+	defer span.End()
+
+	plan, err := findRequest(ctx, s.uow, s.uow.InstallmentPlanRepository().FindByID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Dues = installment.BuildSchedule(plan)
+	return s.toPlanResponse(plan)
+}
+
+// ApproveFinancingRequest approves a financing request under the terms set by the institution.
+// The financed amount is the booking's outstanding balance at approval time. The institution collects
+// the repayments itself, so no dues are generated; the booking's payment deadline is lifted until the
+// disbursement is reported.
+func (s *service) ApproveFinancingRequest(ctx context.Context, id uint, req payload.FinancingApproveRequest) (*payload.InstallmentPlanResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ApproveFinancingRequest")
+	defer span.End()
+
+	firstDueDate, err := time.Parse(time.DateOnly, req.FirstDueDate)
+	if err != nil {
+		return nil, installment.ErrInvalidFirstDueDate(err)
+	}
+	if !firstDueDate.After(time.Now()) {
+		return nil, installment.ErrInvalidFirstDueDate(nil)
+	}
+
+	var plan *model.InstallmentPlan
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		plan, err = findRequest(ctx, uow, uow.InstallmentPlanRepository().FindByIDForUpdate, id)
+		if err != nil {
+			return err
+		}
+
+		if plan.Status != model.InstallmentRequestStatusRequested {
+			return ErrFinancingRequestNotPending(plan.Status)
+		}
+
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, plan.BookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		outstanding := b.TotalAmount.Sub(b.TotalPayment)
+		if (b.Status != model.BookingStatusBooked && b.Status != model.BookingStatusConfirmed) || !outstanding.IsPositive() {
+			return installment.ErrBookingNotInstallable(b.Status, b.PaymentStatus)
+		}
+
+		now := time.Now()
+		by := actor.FromContext(ctx).JSON()
+
+		plan.Terms = req.Terms
+		plan.FirstDueDate = firstDueDate
+		if req.IntervalMonths != nil {
+			plan.IntervalMonths = *req.IntervalMonths
+		}
+		plan.Amount = outstanding
+		plan.Status = model.InstallmentRequestStatusApproved
+		plan.DecisionNotes = req.Notes
+		plan.ApprovedBy = by
+		plan.ApprovedOn = &now
+		plan.ModifiedOn = &now
+		plan.ModifiedBy = by
+
+		plan, err = uow.InstallmentPlanRepository().Update(ctx, plan)
+		if err != nil {
+			return err
+		}
+
+		status := model.InstallmentRequestStatusApproved
+		b.InstallmentRequestStatus = &status
+		b.MaxPaymentTime = nil
+		b.ModifiedOn = &now
+		b.ModifiedBy = by
+
+		_, err = uow.BookingRepository().Update(ctx, b)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Dues = installment.BuildSchedule(plan)
+	return s.toPlanResponse(plan)
+}
+
+// RejectFinancingRequest rejects a financing request, recording the reason as the decision notes.
+// The booking gets a new payment deadline and is expected to be paid directly.
+func (s *service) RejectFinancingRequest(ctx context.Context, id uint, req payload.FinancingRejectRequest) (*payload.InstallmentPlanResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RejectFinancingRequest")
+	defer span.End()
+
+	var plan *model.InstallmentPlan
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		plan, err = findRequest(ctx, uow, uow.InstallmentPlanRepository().FindByIDForUpdate, id)
+		if err != nil {
+			return err
+		}
+
+		if plan.Status != model.InstallmentRequestStatusRequested {
+			return ErrFinancingRequestNotPending(plan.Status)
+		}
+
+		plan, err = installment.Reject(ctx, uow, plan, req.Reason, s.paymentTimeout)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPlanResponse(plan)
+}
+
+// RecordDisbursement records the payout of an approved financing request as a financing payment against its booking,
+// after which the booking's payment status is recalculated like for any other payment. Each request is disbursed once.
+func (s *service) RecordDisbursement(ctx context.Context, id uint, req payload.FinancingDisbursementCreateRequest) (*payload.FinancingDisbursementResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RecordDisbursement")
+	defer span.End()
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, payment.ErrInvalidAmount(err)
+	}
+	if !amount.IsPositive() {
+		return nil, payment.ErrInvalidAmount(nil)
+	}
+
+	disbursedAt := time.Now()
+	if req.DisbursedAt != nil {
+		disbursedAt = *req.DisbursedAt
+	}
+
+	var plan *model.InstallmentPlan
+	var created *model.Payment
+	var updated *model.Booking
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		plan, err = findRequest(ctx, uow, uow.InstallmentPlanRepository().FindByIDForUpdate, id)
+		if err != nil {
+			return err
+		}
+
+		if plan.Status != model.InstallmentRequestStatusApproved || plan.DisbursementPaymentID != nil {
+			return ErrFinancingNotDisbursable(plan.Status, plan.DisbursementPaymentID != nil)
+		}
+
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, plan.BookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		created, updated, err = payment.Apply(ctx, uow, b, &model.Payment{
+			Amount:    amount,
+			Method:    model.PaymentMethodFinancing,
+			Reference: req.Reference,
+			PaidAt:    disbursedAt,
+			Notes:     req.Notes,
+		}, s.rule, s.referralRule)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		plan.DisbursementPaymentID = &created.ID
+		plan.DisbursedOn = &disbursedAt
+		plan.ModifiedOn = &now
+		plan.ModifiedBy = actor.FromContext(ctx).JSON()
+
+		plan, err = uow.InstallmentPlanRepository().Update(ctx, plan)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Dues = installment.BuildSchedule(plan)
+
+	var resp payload.FinancingDisbursementResponse
+	planResp, err := s.toPlanResponse(plan)
+	if err != nil {
+		return nil, err
+	}
+	resp.Plan = *planResp
+
+	err = s.mapper.ToResponse(created, &resp.Payment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.mapper.ToResponse(updated, &resp.Booking)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// toInstitutionResponse maps a financing institution to the response DTO.
+func (s *service) toInstitutionResponse(institution *model.FinancingInstitution) (*payload.FinancingInstitutionResponse, error) {
+	var resp payload.FinancingInstitutionResponse
+	err := s.mapper.ToResponse(institution, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// toPlanResponse maps a financing request and its proposed dues to the response DTO.
+func (s *service) toPlanResponse(plan *model.InstallmentPlan) (*payload.InstallmentPlanResponse, error) {
+	var resp payload.InstallmentPlanResponse
+	err := s.mapper.ToResponse(plan, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Dues == nil {
+		resp.Dues = []payload.InstallmentDueResponse{}
+	}
+
+	return &resp, nil
+}
+
+// findRequest loads an installment plan with the given finder and makes sure it is a financing request
+// the current actor may access. Plans that are not routed to an institution are reported as not found.
+func findRequest(ctx context.Context, uow contract.UnitOfWork, find func(ctx context.Context, id uint) (*model.InstallmentPlan, error), id uint) (*model.InstallmentPlan, error) {
+	plan, err := find(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrFinancingRequestNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	if !plan.IsFinanced() {
+		return nil, ErrFinancingRequestNotFound(nil)
+	}
+
+	err = authorize(ctx, uow, *plan.FinancingInstitutionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// authorize makes sure the current actor may access the financing requests of an institution.
+// Institution users are limited to the institution they belong to; other partners and customers are refused.
+func authorize(ctx context.Context, uow contract.UnitOfWork, institutionID uint) error {
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.AdminRoleFinInst:
+		admin, err := uow.AdminRepository().FindByID(ctx, a.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrFinancingForbidden()
+			}
+
+			return err
+		}
+
+		if admin.FinancingInstitutionID == nil || *admin.FinancingInstitutionID != institutionID {
+			return ErrFinancingForbidden()
+		}
+	case model.UserRoleCustomer, model.UserRoleMuthawif, model.AdminRoleAgent:
+		return ErrFinancingForbidden()
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package installment
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Installment Error Constructors
+// ==========================================================
+
+// ErrInstallmentPlanNotFound creates a new error for missing installment plan records.
+func ErrInstallmentPlanNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"installment plan not found",
+		err,
+		nil,
+	)
+}
+
+// ErrInstallmentPlanExists creates a new error for requesting a second plan on the same booking.
+func ErrInstallmentPlanExists() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking already has an installment plan",
+		nil,
+		nil,
+	)
+}
+
+// ErrBookingNotInstallable creates a new error for requesting a plan on a booking that cannot be paid in installments.
+func ErrBookingNotInstallable(status model.BookingStatus, paymentStatus model.PaymentStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking cannot be paid in installments in its current state",
+		nil,
+		map[string]any{"status": status, "payment_status": paymentStatus},
+	)
+}
+
+// ErrInstallmentPlanNotPending creates a new error for approving a plan that is no longer awaiting approval.
+func ErrInstallmentPlanNotPending(status model.InstallmentRequestStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"installment plan is not awaiting approval",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrInstallmentPlanFinanced creates a new error for deciding in-house on a plan routed to a financing institution.
+func ErrInstallmentPlanFinanced() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"installment plan is decided by its financing institution",
+		nil,
+		nil,
+	)
+}
+
+// ErrInvalidFinancingInstitution creates a new error for routing a plan to an unknown or inactive financing institution.
+func ErrInvalidFinancingInstitution(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"financing institution does not exist or is not active",
+		err,
+		map[string]any{"financing_institution_id": apperror.InvalidValue},
+	)
+}
+
+// ErrInvalidFirstDueDate creates a new error for a first due date that is not in the future.
+func ErrInvalidFirstDueDate(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		err,
+		map[string]any{"first_due_date": apperror.InvalidValue},
+	)
+}
@@ -0,0 +1,206 @@
+package installment
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("installment.handler")
+
+type Handler struct {
+	service contract.InstallmentService
+}
+
+// NewHandler initializes a new instance of InstallmentHandler.
+func NewHandler(service contract.InstallmentService) *Handler {
+	return &Handler{service: service}
+}
+
+// RequestInstallmentPlan handles an installment plan request for a booking.
+func (h *Handler) RequestInstallmentPlan(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "RequestInstallmentPlan")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.InstallmentPlanCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	plan, err := h.service.RequestInstallmentPlan(ctx, uint(bookingID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(plan, nil))
+}
+
+// GetBookingInstallmentPlan retrieves the installment plan of a booking.
+func (h *Handler) GetBookingInstallmentPlan(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookingInstallmentPlan")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	plan, err := h.service.GetBookingInstallmentPlan(ctx, uint(bookingID))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(plan, nil))
+}
+
+// ApproveInstallmentPlan approves a requested installment plan.
+func (h *Handler) ApproveInstallmentPlan(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "ApproveInstallmentPlan")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	plan, err := h.service.ApproveInstallmentPlan(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(plan, nil))
+}
+
+// RejectInstallmentPlan rejects a requested installment plan.
+func (h *Handler) RejectInstallmentPlan(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "RejectInstallmentPlan")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.InstallmentPlanRejectRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	plan, err := h.service.RejectInstallmentPlan(ctx, uint(id), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(plan, nil))
+}
+
+// GetOverdueInstallments retrieves overdue installment dues with pagination.
+func (h *Handler) GetOverdueInstallments(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetOverdueInstallments")
+	defer span.End()
+
+	req := payload.InstallmentOverdueGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	dues, pagination, err := h.service.GetOverdueInstallments(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(dues, pagination))
+}
@@ -0,0 +1,123 @@
+package installment
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/paginator"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("installment.repository")
+
+// PlanRepository implements the contract.InstallmentPlanRepository interface.
+type PlanRepository struct {
+	*repository.GORM[model.InstallmentPlan, model.InstallmentPlanFilter]
+	db *gorm.DB
+}
+
+// NewPlanRepository creates a new installment plan repository instance.
+func NewPlanRepository(db *gorm.DB) *PlanRepository {
+	return &PlanRepository{
+		GORM: repository.NewGORM[model.InstallmentPlan, model.InstallmentPlanFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.InstallmentPlanRepository = (*PlanRepository)(nil)
+
+// FindByBookingID retrieves the active installment plan of a booking with its dues ordered by sequence.
+func (r *PlanRepository) FindByBookingID(ctx context.Context, bookingID uint) (*model.InstallmentPlan, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByBookingID")
+	defer span.End()
+
+	var data model.InstallmentPlan
+	err := r.db.WithContext(ctx).
+		Preload("Dues", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_on IS NULL").Order("sequence ASC")
+		}).
+		Where("deleted_on IS NULL AND booking_id = ?", bookingID).
+		First(&data).Error
+	return &data, err
+}
+
+// DueRepository implements the contract.InstallmentDueRepository interface.
+type DueRepository struct {
+	*repository.GORM[model.InstallmentDue, model.InstallmentDueFilter]
+	db *gorm.DB
+}
+
+// NewDueRepository creates a new installment due repository instance.
+func NewDueRepository(db *gorm.DB) *DueRepository {
+	return &DueRepository{
+		GORM: repository.NewGORM[model.InstallmentDue, model.InstallmentDueFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.InstallmentDueRepository = (*DueRepository)(nil)
+
+// FindOutstandingByBookingIDForUpdate retrieves and locks the unsettled dues of a booking, oldest first.
+// It must be called within a transaction.
+func (r *DueRepository) FindOutstandingByBookingIDForUpdate(ctx context.Context, bookingID uint) ([]model.InstallmentDue, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindOutstandingByBookingIDForUpdate")
+	defer span.End()
+
+	var data []model.InstallmentDue
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_on IS NULL AND booking_id = ? AND paid_amount < amount", bookingID).
+		Order("due_date ASC, sequence ASC").
+		Find(&data).Error
+	return data, err
+}
+
+// FindOverdue retrieves unsettled dues whose due date is before the given date, oldest first.
+func (r *DueRepository) FindOverdue(ctx context.Context, page *int, size *int, before time.Time) ([]model.InstallmentDue, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindOverdue")
+	defer span.End()
+
+	query := r.overdueQuery(ctx, before).Order("due_date ASC, id ASC")
+
+	if page != nil && size != nil {
+		offset := paginator.GetOffset(*page, *size)
+		query = query.Offset(offset).Limit(*size)
+	}
+
+	var data []model.InstallmentDue
+	err := query.Find(&data).Error
+	return data, err
+}
+
+// CountOverdue returns the number of unsettled dues whose due date is before the given date.
+func (r *DueRepository) CountOverdue(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := repositoryTracer.Start(ctx, "CountOverdue")
+	defer span.End()
+
+	var count int64
+	err := r.overdueQuery(ctx, before).Count(&count).Error
+	return count, err
+}
+
+// SaveAll persists a batch of new installment due records in a single statement.
+func (r *DueRepository) SaveAll(ctx context.Context, dues []model.InstallmentDue) error {
+	ctx, span := repositoryTracer.Start(ctx, "SaveAll")
+	defer span.End()
+
+	return r.db.WithContext(ctx).Create(&dues).Error
+}
+
+// overdueQuery builds the base query for unsettled dues of active plans that are past their due date.
+func (r *DueRepository) overdueQuery(ctx context.Context, before time.Time) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&model.InstallmentDue{}).
+		Where("deleted_on IS NULL AND paid_amount < amount AND due_date < ?", before)
+}
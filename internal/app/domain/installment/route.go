@@ -0,0 +1,19 @@
+package installment
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers installment-related routes to the provided router group.
+// Approving and rejecting plans and listing overdue dues requires the installment:approve permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	router.Post("/bookings/:id/installments", handler.RequestInstallmentPlan)
+	router.Get("/bookings/:id/installments", handler.GetBookingInstallmentPlan)
+
+	installments := router.Group("/installments")
+	installments.Get("/overdue", authz.Require(rbac.InstallmentApprove), handler.GetOverdueInstallments)
+	installments.Post("/:id/approve", authz.Require(rbac.InstallmentApprove), handler.ApproveInstallmentPlan)
+	installments.Post("/:id/reject", authz.Require(rbac.InstallmentApprove), handler.RejectInstallmentPlan)
+}
@@ -0,0 +1,75 @@
+package installment
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/shopspring/decimal"
+)
+
+// BuildSchedule splits the plan amount into equal dues, one every IntervalMonths starting at FirstDueDate.
+// Amounts are rounded down to two decimals and the remainder is added to the last due.
+func BuildSchedule(plan *model.InstallmentPlan) []model.InstallmentDue {
+	terms := decimal.NewFromInt(int64(plan.Terms))
+	base := plan.Amount.Div(terms).RoundDown(2)
+	remainder := plan.Amount.Sub(base.Mul(terms))
+
+	dues := make([]model.InstallmentDue, 0, plan.Terms)
+	for i := 0; i < plan.Terms; i++ {
+		amount := base
+		if i == plan.Terms-1 {
+			amount = amount.Add(remainder)
+		}
+
+		dues = append(dues, model.InstallmentDue{
+			PlanID:     plan.ID,
+			BookingID:  plan.BookingID,
+			Sequence:   i + 1,
+			DueDate:    plan.FirstDueDate.AddDate(0, i*plan.IntervalMonths, 0),
+			Amount:     amount,
+			PaidAmount: decimal.Zero,
+		})
+	}
+
+	return dues
+}
+
+// AllocatePayment applies an incoming payment to the booking's outstanding installment dues, oldest due first.
+// Bookings without an approved plan have no dues, in which case this is a no-op. It must be called within a transaction.
+func AllocatePayment(ctx context.Context, uow contract.UnitOfWork, bookingID uint, amount decimal.Decimal, paidAt time.Time) error {
+	dues, err := uow.InstallmentDueRepository().FindOutstandingByBookingIDForUpdate(ctx, bookingID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	by := actor.FromContext(ctx).JSON()
+
+	remaining := amount
+	for i := range dues {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		due := &dues[i]
+		applied := decimal.Min(remaining, due.Outstanding())
+
+		due.PaidAmount = due.PaidAmount.Add(applied)
+		if due.Outstanding().IsZero() {
+			due.PaidOn = &paidAt
+		}
+		due.ModifiedOn = &now
+		due.ModifiedBy = by
+
+		if _, err := uow.InstallmentDueRepository().Update(ctx, due); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(applied)
+	}
+
+	return nil
+}
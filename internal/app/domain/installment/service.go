@@ -0,0 +1,407 @@
+package installment
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("installment.service")
+
+// Option holds the settings of the installment service.
+type Option struct {
+	// PaymentTimeout is the time a booking has to receive a payment once its plan is rejected.
+	PaymentTimeout time.Duration
+}
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+	opt    Option
+}
+
+// NewService initializes a new instance of installment service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, opt Option) *service {
+	return &service{uow: uow, mapper: mapper, opt: opt}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.InstallmentService = (*service)(nil)
+
+// RequestInstallmentPlan submits an installment plan request for a booking.
+// The plan covers the outstanding balance at request time; the returned dues are a preview
+// of the schedule that will be generated once the plan is approved.
+// Requests naming a financing institution are routed to it and decided through the financing channel.
+// The booking's payment deadline is lifted while the request is pending, so it does not expire awaiting a decision.
+// Customers can only request a plan for their own bookings.
+func (s *service) RequestInstallmentPlan(ctx context.Context, bookingID uint, req payload.InstallmentPlanCreateRequest) (*payload.InstallmentPlanResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RequestInstallmentPlan")
+	defer span.End()
+
+	firstDueDate, err := time.Parse(time.DateOnly, req.FirstDueDate)
+	if err != nil {
+		return nil, ErrInvalidFirstDueDate(err)
+	}
+	if !firstDueDate.After(time.Now()) {
+		return nil, ErrInvalidFirstDueDate(nil)
+	}
+
+	intervalMonths := 1
+	if req.IntervalMonths != nil {
+		intervalMonths = *req.IntervalMonths
+	}
+
+	var plan *model.InstallmentPlan
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		err = booking.Authorize(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		outstanding := b.TotalAmount.Sub(b.TotalPayment)
+		if (b.Status != model.BookingStatusBooked && b.Status != model.BookingStatusConfirmed) || !outstanding.IsPositive() {
+			return ErrBookingNotInstallable(b.Status, b.PaymentStatus)
+		}
+
+		_, err = uow.InstallmentPlanRepository().FindByBookingID(ctx, b.ID)
+		if err == nil {
+			return ErrInstallmentPlanExists()
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if req.FinancingInstitutionID != nil {
+			institution, err := uow.FinancingInstitutionRepository().FindByID(ctx, *req.FinancingInstitutionID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrInvalidFinancingInstitution(err)
+				}
+
+				return err
+			}
+
+			if institution.IsActive != nil && !*institution.IsActive {
+				return ErrInvalidFinancingInstitution(nil)
+			}
+		}
+
+		by := actor.FromContext(ctx).JSON()
+
+		plan, err = uow.InstallmentPlanRepository().Save(ctx, &model.InstallmentPlan{
+			BookingID:              b.ID,
+			Status:                 model.InstallmentRequestStatusRequested,
+			Terms:                  req.Terms,
+			IntervalMonths:         intervalMonths,
+			FirstDueDate:           firstDueDate,
+			Amount:                 outstanding,
+			FinancingInstitutionID: req.FinancingInstitutionID,
+			CreatedBy:              by,
+		})
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		status := model.InstallmentRequestStatusRequested
+		b.InstallmentRequestStatus = &status
+		b.MaxPaymentTime = nil
+		b.ModifiedOn = &now
+		b.ModifiedBy = by
+
+		_, err = uow.BookingRepository().Update(ctx, b)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Dues = BuildSchedule(plan)
+	return s.toPlanResponse(plan)
+}
+
+// ApproveInstallmentPlan approves a requested plan and generates its schedule of dues.
+// The schedule covers the outstanding balance at approval time, so payments made while the request
+// was pending are taken into account. Once approved, the dues replace the booking's single payment deadline.
+// Plans routed to a financing institution can only be decided by that institution.
+func (s *service) ApproveInstallmentPlan(ctx context.Context, id uint) (*payload.InstallmentPlanResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ApproveInstallmentPlan")
+	defer span.End()
+
+	var plan *model.InstallmentPlan
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		plan, err = uow.InstallmentPlanRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInstallmentPlanNotFound(err)
+			}
+
+			return err
+		}
+
+		if plan.Status != model.InstallmentRequestStatusRequested {
+			return ErrInstallmentPlanNotPending(plan.Status)
+		}
+
+		if plan.IsFinanced() {
+			return ErrInstallmentPlanFinanced()
+		}
+
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, plan.BookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		outstanding := b.TotalAmount.Sub(b.TotalPayment)
+		if (b.Status != model.BookingStatusBooked && b.Status != model.BookingStatusConfirmed) || !outstanding.IsPositive() {
+			return ErrBookingNotInstallable(b.Status, b.PaymentStatus)
+		}
+
+		now := time.Now()
+		by := actor.FromContext(ctx).JSON()
+
+		plan.Amount = outstanding
+		plan.Status = model.InstallmentRequestStatusApproved
+		plan.ApprovedBy = by
+		plan.ApprovedOn = &now
+		plan.ModifiedOn = &now
+		plan.ModifiedBy = by
+
+		plan, err = uow.InstallmentPlanRepository().Update(ctx, plan)
+		if err != nil {
+			return err
+		}
+
+		dues := BuildSchedule(plan)
+		for i := range dues {
+			dues[i].CreatedBy = by
+		}
+
+		err = uow.InstallmentDueRepository().SaveAll(ctx, dues)
+		if err != nil {
+			return err
+		}
+		plan.Dues = dues
+
+		status := model.InstallmentRequestStatusApproved
+		b.InstallmentRequestStatus = &status
+		b.MaxPaymentTime = nil
+		b.ModifiedOn = &now
+		b.ModifiedBy = by
+
+		_, err = uow.BookingRepository().Update(ctx, b)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPlanResponse(plan)
+}
+
+// RejectInstallmentPlan rejects a requested plan, recording the reason as the decision notes.
+// Plans routed to a financing institution can only be decided by that institution.
+func (s *service) RejectInstallmentPlan(ctx context.Context, id uint, req payload.InstallmentPlanRejectRequest) (*payload.InstallmentPlanResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RejectInstallmentPlan")
+	defer span.End()
+
+	var plan *model.InstallmentPlan
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		plan, err = uow.InstallmentPlanRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInstallmentPlanNotFound(err)
+			}
+
+			return err
+		}
+
+		if plan.Status != model.InstallmentRequestStatusRequested {
+			return ErrInstallmentPlanNotPending(plan.Status)
+		}
+
+		if plan.IsFinanced() {
+			return ErrInstallmentPlanFinanced()
+		}
+
+		plan, err = Reject(ctx, uow, plan, req.Reason, s.opt.PaymentTimeout)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPlanResponse(plan)
+}
+
+// Reject rejects a requested plan within the caller's transaction and releases its booking:
+// the booking's quantity can change again and it gets a new payment deadline of paymentTimeout,
+// since its deadline was lifted while the plan was pending. A zero paymentTimeout sets no deadline.
+func Reject(ctx context.Context, uow contract.UnitOfWork, plan *model.InstallmentPlan, reason string, paymentTimeout time.Duration) (*model.InstallmentPlan, error) {
+	b, err := uow.BookingRepository().FindByIDForUpdate(ctx, plan.BookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	now := time.Now()
+	by := actor.FromContext(ctx).JSON()
+
+	plan.Status = model.InstallmentRequestStatusRejected
+	plan.DecisionNotes = &reason
+	plan.RejectedBy = by
+	plan.RejectedOn = &now
+	plan.ModifiedOn = &now
+	plan.ModifiedBy = by
+
+	plan, err = uow.InstallmentPlanRepository().Update(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	status := model.InstallmentRequestStatusRejected
+	b.InstallmentRequestStatus = &status
+	if paymentTimeout > 0 {
+		deadline := now.Add(paymentTimeout)
+		b.MaxPaymentTime = &deadline
+	}
+	b.ModifiedOn = &now
+	b.ModifiedBy = by
+
+	_, err = uow.BookingRepository().Update(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// GetBookingInstallmentPlan retrieves the installment plan of a booking.
+// For plans awaiting approval and financed plans, whose repayments are collected by the institution,
+// the proposed schedule is returned as dues. Customers can only view the plans of their own bookings.
+func (s *service) GetBookingInstallmentPlan(ctx context.Context, bookingID uint) (*payload.InstallmentPlanResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingInstallmentPlan")
+	defer span.End()
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = booking.Authorize(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.uow.InstallmentPlanRepository().FindByBookingID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInstallmentPlanNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	if plan.Status == model.InstallmentRequestStatusRequested || plan.IsFinanced() {
+		plan.Dues = BuildSchedule(plan)
+	}
+
+	return s.toPlanResponse(plan)
+}
+
+// GetOverdueInstallments retrieves unsettled dues past their due date for collections, oldest first.
+func (s *service) GetOverdueInstallments(ctx context.Context, req payload.InstallmentOverdueGetAllRequest) ([]payload.InstallmentOverdueResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetOverdueInstallments")
+	defer span.End()
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var count int64
+	var dues []model.InstallmentDue
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.InstallmentDueRepository().CountOverdue(groupCtx, today)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		dues, err = s.uow.InstallmentDueRepository().FindOverdue(groupCtx, req.Page, req.Size, today)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := make([]payload.InstallmentOverdueResponse, 0, len(dues))
+	for _, due := range dues {
+		resp = append(resp, payload.InstallmentOverdueResponse{
+			ID:          due.ID,
+			PlanID:      due.PlanID,
+			BookingID:   due.BookingID,
+			Sequence:    due.Sequence,
+			DueDate:     due.DueDate,
+			Amount:      due.Amount.StringFixed(2),
+			PaidAmount:  due.PaidAmount.StringFixed(2),
+			Outstanding: due.Outstanding().StringFixed(2),
+			DaysOverdue: int(today.Sub(due.DueDate).Hours() / 24),
+		})
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// toPlanResponse maps an installment plan and its dues to the response DTO.
+func (s *service) toPlanResponse(plan *model.InstallmentPlan) (*payload.InstallmentPlanResponse, error) {
+	var resp payload.InstallmentPlanResponse
+	err := s.mapper.ToResponse(plan, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Dues == nil {
+		resp.Dues = []payload.InstallmentDueResponse{}
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,30 @@
+package invoice
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Invoice Error Constructors
+// ==========================================================
+
+// ErrInvoiceNotFound creates a new error for missing invoice records.
+func ErrInvoiceNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"invoice not found",
+		err,
+		nil,
+	)
+}
+
+// ErrBookingNotInvoiceable creates a new error for issuing an invoice on a booking that was canceled.
+func ErrBookingNotInvoiceable(status model.BookingStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking cannot be invoiced in its current status",
+		nil,
+		map[string]any{"status": status},
+	)
+}
@@ -0,0 +1,169 @@
+package invoice
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("invoice.handler")
+
+type Handler struct {
+	service contract.InvoiceService
+}
+
+// NewHandler initializes a new instance of InvoiceHandler.
+func NewHandler(service contract.InvoiceService) *Handler {
+	return &Handler{service: service}
+}
+
+// GetBookingInvoice downloads the invoice of a booking as a PDF.
+func (h *Handler) GetBookingInvoice(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookingInvoice")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	file, err := h.service.GetBookingInvoice(ctx, uint(bookingID))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return sendFile(c, file)
+}
+
+// GetBookingReceipt downloads the receipt of a payment made on a booking as a PDF.
+func (h *Handler) GetBookingReceipt(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookingReceipt")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	paymentID, err := strconv.Atoi(c.Params("payment_id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid payment id", nil),
+		)
+	}
+
+	file, err := h.service.GetBookingReceipt(ctx, uint(bookingID), uint(paymentID))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return sendFile(c, file)
+}
+
+// GetInvoices retrieves a list of invoices with pagination and filtering.
+func (h *Handler) GetInvoices(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetInvoices")
+	defer span.End()
+
+	req := payload.InvoiceGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	invoices, pagination, err := h.service.GetAllInvoices(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(invoices, pagination))
+}
+
+// GetInvoice retrieves a single invoice by its ID.
+func (h *Handler) GetInvoice(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetInvoice")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	invoice, err := h.service.GetInvoiceByID(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(invoice, nil))
+}
+
+// sendFile writes a rendered document as a download.
+func sendFile(c *fiber.Ctx, file *payload.FileResponse) error {
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.Name))
+	return c.Send(file.Content)
+}
@@ -0,0 +1,228 @@
+package invoice
+
+import (
+	"strings"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/pdf"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// currency is printed in front of every amount.
+	currency = "IDR"
+
+	// dateLayout is the date format printed on documents.
+	dateLayout = "02 Jan 2006"
+
+	marginLeft   = 50.0
+	marginRight  = pdf.PageWidth - 50.0
+	marginBottom = pdf.PageHeight - 60.0
+)
+
+// receipt holds the details of a single payment receipt.
+type receipt struct {
+	Number     string
+	Payment    model.Payment
+	PaidToDate decimal.Decimal
+}
+
+// writer lays out lines of text top to bottom, starting a new page when the current one is full.
+type writer struct {
+	doc  *pdf.Document
+	page *pdf.Page
+	y    float64
+}
+
+func newWriter(title string) *writer {
+	doc := pdf.New(title)
+	return &writer{doc: doc, page: doc.AddPage(), y: 60}
+}
+
+// advance moves down by h points, breaking to a new page when the space runs out.
+func (w *writer) advance(h float64) {
+	w.y += h
+	if w.y > marginBottom {
+		w.page = w.doc.AddPage()
+		w.y = 60
+	}
+}
+
+// header prints the issuer on the left and the document title on the right.
+func (w *writer) header(opt Option, title string) {
+	w.page.Text(marginLeft, w.y, pdf.Bold, 16, opt.IssuerName)
+	w.page.TextRight(marginRight, w.y, pdf.Bold, 20, title)
+	for _, line := range strings.Split(opt.IssuerAddress, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		w.advance(13)
+		w.page.Text(marginLeft, w.y, pdf.Regular, 9, strings.TrimSpace(line))
+	}
+	w.advance(16)
+	w.page.Line(marginLeft, w.y, marginRight, w.y, 1)
+	w.advance(24)
+}
+
+// field prints a label and its value on one line.
+func (w *writer) field(label, value string) {
+	w.page.Text(marginLeft, w.y, pdf.Bold, 10, label)
+	w.page.Text(marginLeft+110, w.y, pdf.Regular, 10, value)
+	w.advance(15)
+}
+
+// total prints a right-aligned label and amount, used for the summary below a table.
+func (w *writer) total(label string, amount decimal.Decimal, font pdf.Font) {
+	w.page.TextRight(marginRight-130, w.y, font, 10, label)
+	w.page.TextRight(marginRight, w.y, font, 10, formatAmount(amount))
+	w.advance(15)
+}
+
+// renderInvoice lays out an invoice with its lines, totals and the payments received on the booking.
+func renderInvoice(opt Option, inv *model.Invoice, b *model.Booking, payments []model.Payment) []byte {
+	w := newWriter("Invoice " + inv.Number)
+	w.header(opt, "INVOICE")
+
+	w.field("Invoice No.", inv.Number)
+	w.field("Issued", inv.IssuedOn.Format(dateLayout))
+	w.field("Booking", inv.BookingCode)
+	w.field("Billed To", inv.CustomerName)
+	w.advance(15)
+
+	// Line items
+	w.page.FillRect(marginLeft, w.y-12, marginRight-marginLeft, 18, 0.9)
+	w.page.Text(marginLeft+4, w.y, pdf.Bold, 9, "Description")
+	w.page.TextRight(320, w.y, pdf.Bold, 9, "Qty")
+	w.page.TextRight(405, w.y, pdf.Bold, 9, "Unit Price")
+	w.page.TextRight(475, w.y, pdf.Bold, 9, "Discount")
+	w.page.TextRight(marginRight-4, w.y, pdf.Bold, 9, "Amount")
+	w.advance(20)
+
+	for _, line := range inv.Lines {
+		w.page.Text(marginLeft+4, w.y, pdf.Regular, 9, truncate(line.Description, pdf.Regular, 9, 240))
+		w.page.TextRight(320, w.y, pdf.Regular, 9, decimal.NewFromInt(int64(line.Quantity)).String())
+		w.page.TextRight(405, w.y, pdf.Regular, 9, formatNumber(line.UnitPrice))
+		w.page.TextRight(475, w.y, pdf.Regular, 9, formatNumber(line.DiscountAmount))
+		w.page.TextRight(marginRight-4, w.y, pdf.Regular, 9, formatNumber(line.TotalAmount))
+		w.advance(15)
+	}
+
+	w.page.Line(marginLeft, w.y-8, marginRight, w.y-8, 0.5)
+	w.advance(8)
+
+	w.total("Subtotal", inv.Subtotal, pdf.Regular)
+	if inv.DiscountAmount.IsPositive() {
+		w.total("Discount", inv.DiscountAmount.Neg(), pdf.Regular)
+	}
+	w.total("Total", inv.TotalAmount, pdf.Bold)
+	if inv.TaxPercent.IsPositive() {
+		w.total("Includes tax "+inv.TaxPercent.String()+"%", inv.TaxAmount, pdf.Regular)
+	}
+	w.advance(15)
+
+	// Payments received so far, against the current booking total
+	w.page.Text(marginLeft, w.y, pdf.Bold, 11, "Payments")
+	w.advance(18)
+	if len(payments) == 0 {
+		w.page.Text(marginLeft, w.y, pdf.Regular, 9, "No payments received yet.")
+		w.advance(15)
+	}
+	for _, p := range payments {
+		w.page.Text(marginLeft, w.y, pdf.Regular, 9, p.PaidAt.Format(dateLayout))
+		w.page.Text(marginLeft+80, w.y, pdf.Regular, 9, methodLabel(p.Method))
+		if p.Reference != nil {
+			w.page.Text(marginLeft+190, w.y, pdf.Regular, 9, truncate(*p.Reference, pdf.Regular, 9, 170))
+		}
+		w.page.TextRight(marginRight, w.y, pdf.Regular, 9, formatAmount(p.Amount))
+		w.advance(14)
+	}
+
+	w.page.Line(marginLeft, w.y-8, marginRight, w.y-8, 0.5)
+	w.advance(8)
+	w.total("Paid", b.TotalPayment, pdf.Regular)
+	w.total("Balance Due", balance(b.TotalAmount, b.TotalPayment), pdf.Bold)
+
+	return w.doc.Bytes()
+}
+
+// renderReceipt lays out the receipt of a single payment with the booking balance after it.
+func renderReceipt(opt Option, inv *model.Invoice, b *model.Booking, r receipt) []byte {
+	w := newWriter("Receipt " + r.Number)
+	w.header(opt, "RECEIPT")
+
+	w.field("Receipt No.", r.Number)
+	w.field("Invoice No.", inv.Number)
+	w.field("Booking", inv.BookingCode)
+	w.field("Received From", inv.CustomerName)
+	w.field("Payment Date", r.Payment.PaidAt.Format(dateLayout))
+	w.field("Method", methodLabel(r.Payment.Method))
+	if r.Payment.Reference != nil {
+		w.field("Reference", *r.Payment.Reference)
+	}
+	w.advance(15)
+
+	w.page.FillRect(marginLeft, w.y-16, marginRight-marginLeft, 26, 0.9)
+	w.page.Text(marginLeft+6, w.y, pdf.Bold, 12, "Amount Received")
+	w.page.TextRight(marginRight-6, w.y, pdf.Bold, 12, formatAmount(r.Payment.Amount))
+	w.advance(30)
+
+	w.total("Booking Total", b.TotalAmount, pdf.Regular)
+	w.total("Paid To Date", r.PaidToDate, pdf.Regular)
+	w.total("Balance", balance(b.TotalAmount, r.PaidToDate), pdf.Bold)
+
+	return w.doc.Bytes()
+}
+
+// balance returns the amount still owed, which is never negative.
+func balance(total, paid decimal.Decimal) decimal.Decimal {
+	return decimal.Max(total.Sub(paid), decimal.Zero)
+}
+
+// formatAmount formats an amount with the currency, e.g. "IDR 12,500,000.00".
+func formatAmount(d decimal.Decimal) string {
+	return currency + " " + formatNumber(d)
+}
+
+// formatNumber formats a number with thousands separators and two decimals, e.g. "12,500,000.00".
+func formatNumber(d decimal.Decimal) string {
+	s := d.Abs().StringFixed(2)
+	intPart, frac := s[:len(s)-3], s[len(s)-3:]
+
+	var b strings.Builder
+	if d.IsNegative() {
+		b.WriteByte('-')
+	}
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+	b.WriteString(frac)
+
+	return b.String()
+}
+
+// truncate shortens s with an ellipsis so it fits within maxWidth points.
+func truncate(s string, font pdf.Font, size, maxWidth float64) string {
+	if pdf.TextWidth(font, size, s) <= maxWidth {
+		return s
+	}
+
+	runes := []rune(s)
+	for len(runes) > 0 && pdf.TextWidth(font, size, string(runes)+"...") > maxWidth {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+// methodLabel turns a payment method code such as "bank_transfer" into "Bank Transfer".
+func methodLabel(method string) string {
+	words := strings.Split(method, "_")
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,95 @@
+package invoice
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("invoice.repository")
+
+// Repository implements the contract.InvoiceRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Invoice, model.InvoiceFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new invoice repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Invoice, model.InvoiceFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.InvoiceRepository = (*Repository)(nil)
+
+// FindByID retrieves a single invoice by its ID with its lines ordered by line number.
+func (r *Repository) FindByID(ctx context.Context, id uint) (*model.Invoice, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	var data model.Invoice
+	err := r.withLines(ctx).
+		Where("deleted_on IS NULL").
+		First(&data, id).Error
+	return &data, err
+}
+
+// FindByBookingID retrieves the current, not voided, invoice of a booking with its lines ordered by line number.
+func (r *Repository) FindByBookingID(ctx context.Context, bookingID uint) (*model.Invoice, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByBookingID")
+	defer span.End()
+
+	var data model.Invoice
+	err := r.withLines(ctx).
+		Where("deleted_on IS NULL AND voided_on IS NULL AND booking_id = ?", bookingID).
+		First(&data).Error
+	return &data, err
+}
+
+// Void marks an invoice as voided at the given time, so it stops being the current invoice of its booking.
+func (r *Repository) Void(ctx context.Context, id uint, at time.Time) error {
+	ctx, span := repositoryTracer.Start(ctx, "Void")
+	defer span.End()
+
+	return r.db.WithContext(ctx).
+		Model(&model.Invoice{}).
+		Where("id = ? AND deleted_on IS NULL AND voided_on IS NULL", id).
+		Update("voided_on", at).Error
+}
+
+// NextSequence reserves the next invoice number of a period and returns it.
+// The upsert keeps the period's counter row locked until the transaction ends, so concurrent
+// issuers are serialized and a rolled back transaction gives its number back, leaving no gaps.
+// It must be called within a transaction.
+func (r *Repository) NextSequence(ctx context.Context, period string) (int, error) {
+	ctx, span := repositoryTracer.Start(ctx, "NextSequence")
+	defer span.End()
+
+	var next int
+	err := r.db.WithContext(ctx).Raw(`
+  INSERT INTO "transaction"."invoice_sequences" ("period", "last_number")
+  VALUES (?, 1)
+  ON CONFLICT ("period") DO UPDATE
+    SET "last_number" = "invoice_sequences"."last_number" + 1, "modified_on" = CURRENT_TIMESTAMP
+  RETURNING "last_number"`, period).
+		Scan(&next).Error
+	return next, err
+}
+
+// withLines builds the base query that preloads the active lines of an invoice.
+func (r *Repository) withLines(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_on IS NULL").Order("line_no ASC")
+		})
+}
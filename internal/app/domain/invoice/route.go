@@ -0,0 +1,18 @@
+package invoice
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers invoice-related routes to the provided router group.
+// Listing and viewing invoices outside their booking requires the invoice:list permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	router.Get("/bookings/:id/invoice", handler.GetBookingInvoice)
+	router.Get("/bookings/:id/invoice/receipts/:payment_id", handler.GetBookingReceipt)
+
+	invoices := router.Group("/invoices", authz.Require(rbac.InvoiceList))
+	invoices.Get("/", handler.GetInvoices)
+	invoices.Get("/:id", handler.GetInvoice)
+}
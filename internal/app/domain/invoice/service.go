@@ -0,0 +1,388 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/domain/payment"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("invoice.service")
+
+// Option holds the configurable details printed on invoices and receipts.
+type Option struct {
+	// NumberPrefix is prepended to invoice numbers, e.g. INV-202610-00042.
+	NumberPrefix string
+
+	// TaxPercent is the tax rate included in booking prices. A zero value prints no tax.
+	TaxPercent decimal.Decimal
+
+	// IssuerName and IssuerAddress identify the company issuing the documents.
+	IssuerName    string
+	IssuerAddress string
+}
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+	opt    Option
+}
+
+// NewService initializes a new instance of invoice service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, opt Option) *service {
+	return &service{uow: uow, mapper: mapper, opt: opt}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.InvoiceService = (*service)(nil)
+
+// GetBookingInvoice renders the invoice of a booking as a PDF together with the payments received so far.
+// The invoice is issued on first request and replaced under a new number when the booking's amounts change.
+// Customers can only get the invoices of their own bookings.
+func (s *service) GetBookingInvoice(ctx context.Context, bookingID uint) (*payload.FileResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingInvoice")
+	defer span.End()
+
+	err := s.authorize(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := s.issue(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	b, payments, err := s.findBookingPayments(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payload.FileResponse{
+		Name:        inv.Number + ".pdf",
+		ContentType: "application/pdf",
+		Content:     renderInvoice(s.opt, inv, b, payments),
+	}, nil
+}
+
+// GetBookingReceipt renders the receipt of a single payment on a booking as a PDF.
+// Receipts are numbered after the booking's invoice in the order the payments were made.
+// Customers can only get the receipts of their own bookings.
+func (s *service) GetBookingReceipt(ctx context.Context, bookingID uint, paymentID uint) (*payload.FileResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingReceipt")
+	defer span.End()
+
+	err := s.authorize(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := s.issue(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	b, payments, err := s.findBookingPayments(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := slices.IndexFunc(payments, func(p model.Payment) bool { return p.ID == paymentID })
+	if idx < 0 {
+		return nil, payment.ErrPaymentNotFound(nil)
+	}
+
+	paidToDate := decimal.Zero
+	for _, p := range payments[:idx+1] {
+		paidToDate = paidToDate.Add(p.Amount)
+	}
+
+	r := receipt{
+		Number:     fmt.Sprintf("%s-R%02d", inv.Number, idx+1),
+		Payment:    payments[idx],
+		PaidToDate: paidToDate,
+	}
+
+	return &payload.FileResponse{
+		Name:        r.Number + ".pdf",
+		ContentType: "application/pdf",
+		Content:     renderReceipt(s.opt, inv, b, r),
+	}, nil
+}
+
+// GetAllInvoices retrieves a list of invoices with pagination and filtering.
+func (s *service) GetAllInvoices(ctx context.Context, req payload.InvoiceGetAllRequest) ([]payload.InvoiceBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllInvoices")
+	defer span.End()
+
+	if req.InvoiceFilter == nil {
+		req.InvoiceFilter = &model.InvoiceFilter{}
+	}
+
+	var count int64
+	var invoices []model.Invoice
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.InvoiceRepository().Count(groupCtx, req.InvoiceFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		invoices, err = s.uow.InvoiceRepository().FindAll(groupCtx, req.Page, req.Size, req.InvoiceFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := []payload.InvoiceBaseResponse{}
+	err = s.mapper.ToResponse(invoices, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetInvoiceByID retrieves a single invoice with its lines.
+func (s *service) GetInvoiceByID(ctx context.Context, id uint) (*payload.InvoiceBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetInvoiceByID")
+	defer span.End()
+
+	inv, err := s.uow.InvoiceRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvoiceNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	var resp payload.InvoiceBaseResponse
+	err = s.mapper.ToResponse(inv, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// authorize checks that the actor may access the documents of a booking.
+// It runs before an invoice is issued, so an actor without access cannot use up invoice numbers.
+func (s *service) authorize(ctx context.Context, bookingID uint) error {
+	b, err := s.uow.BookingRepository().FindByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return booking.ErrBookingNotFound(err)
+		}
+
+		return err
+	}
+
+	return booking.Authorize(ctx, b)
+}
+
+// issue returns the current invoice of a booking, issuing it first when the booking has none.
+// When the booking's amounts, quantity or departure changed since, the invoice is voided and a
+// replacement referencing it is issued under a new number.
+// The booking row is locked while issuing so concurrent requests end up with a single invoice,
+// and the number is reserved in the same transaction so a failed issue leaves no gap.
+func (s *service) issue(ctx context.Context, bookingID uint) (*model.Invoice, error) {
+	inv, err := s.uow.InvoiceRepository().FindByBookingID(ctx, bookingID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		b, err := s.uow.BookingRepository().FindByID(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, booking.ErrBookingNotFound(err)
+			}
+
+			return nil, err
+		}
+
+		if !outdated(inv, b) {
+			return inv, nil
+		}
+	}
+
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		// Another request may have issued the invoice while this one waited for the lock
+		current, err := uow.InvoiceRepository().FindByBookingID(ctx, b.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err == nil && !outdated(current, b) {
+			inv = current
+			return nil
+		}
+
+		if current == nil && b.Status == model.BookingStatusCanceled {
+			return ErrBookingNotInvoiceable(b.Status)
+		}
+
+		now := time.Now()
+		if current != nil {
+			err = uow.InvoiceRepository().Void(ctx, current.ID, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		period := now.Format("2006-01")
+		seq, err := uow.InvoiceRepository().NextSequence(ctx, period)
+		if err != nil {
+			return err
+		}
+
+		inv = buildInvoice(b, s.opt.TaxPercent)
+		inv.Number = fmt.Sprintf("%s-%s-%05d", s.opt.NumberPrefix, now.Format("200601"), seq)
+		inv.Period = period
+		inv.Sequence = seq
+		inv.IssuedOn = now
+		if current != nil {
+			inv.ReplacesInvoiceID = &current.ID
+		}
+
+		by := actor.FromContext(ctx).JSON()
+		inv.CreatedBy = by
+		for i := range inv.Lines {
+			inv.Lines[i].CreatedBy = by
+		}
+
+		inv, err = uow.InvoiceRepository().Save(ctx, inv)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// outdated reports whether an invoice no longer matches its booking, so it has to be replaced.
+// The invoice is rebuilt at its own tax rate, so a later tax rate change alone does not replace it.
+func outdated(inv *model.Invoice, b *model.Booking) bool {
+	want := buildInvoice(b, inv.TaxPercent)
+	if !inv.TotalAmount.Equal(want.TotalAmount) ||
+		!inv.DiscountAmount.Equal(want.DiscountAmount) ||
+		!inv.Subtotal.Equal(want.Subtotal) ||
+		len(inv.Lines) != len(want.Lines) {
+		return true
+	}
+
+	for i, l := range inv.Lines {
+		w := want.Lines[i]
+		if !equalID(l.ProductID, w.ProductID) || l.Description != w.Description || l.Quantity != w.Quantity {
+			return true
+		}
+	}
+
+	return false
+}
+
+// equalID reports whether two optional identifiers are both unset or hold the same value.
+func equalID(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// findBookingPayments retrieves a booking and its payments in the order they were made.
+func (s *service) findBookingPayments(ctx context.Context, bookingID uint) (*model.Booking, []model.Payment, error) {
+	b, err := s.uow.BookingRepository().FindByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, nil, err
+	}
+
+	payments, err := s.uow.PaymentRepository().FindAll(ctx, nil, nil, &model.PaymentFilter{BookingID: &b.ID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	slices.SortFunc(payments, func(a, b model.Payment) int {
+		if c := a.PaidAt.Compare(b.PaidAt); c != 0 {
+			return c
+		}
+		return int(a.ID) - int(b.ID)
+	})
+
+	return b, payments, nil
+}
+
+// buildInvoice computes the amounts and lines of a new invoice from a booking.
+// Booking prices are tax-inclusive, so the tax is the included share of the total.
+func buildInvoice(b *model.Booking, taxPercent decimal.Decimal) *model.Invoice {
+	hundred := decimal.NewFromInt(100)
+
+	subtotal := b.TotalAmount.Add(b.DiscountAmount)
+	tax := decimal.Zero
+	if taxPercent.IsPositive() {
+		tax = b.TotalAmount.Mul(taxPercent).Div(hundred.Add(taxPercent)).Round(2)
+	}
+
+	description := "Travel package"
+	if b.ProductName != nil && *b.ProductName != "" {
+		description = *b.ProductName
+	}
+	if b.Date != nil {
+		description += " - departure " + b.Date.Format(time.DateOnly)
+	}
+
+	return &model.Invoice{
+		BookingID:      b.ID,
+		BookingCode:    b.Code,
+		CustomerName:   b.UserFullName,
+		Subtotal:       subtotal,
+		DiscountAmount: b.DiscountAmount,
+		TaxPercent:     taxPercent,
+		TaxAmount:      tax,
+		TotalAmount:    b.TotalAmount,
+		Lines: []model.InvoiceLine{{
+			LineNo:         1,
+			ProductID:      b.ProductID,
+			Description:    description,
+			Quantity:       b.TotalQty,
+			UnitPrice:      subtotal.DivRound(decimal.NewFromInt(int64(b.TotalQty)), 2),
+			DiscountAmount: b.DiscountAmount,
+			TaxAmount:      tax,
+			TotalAmount:    b.TotalAmount,
+		}},
+	}
+}
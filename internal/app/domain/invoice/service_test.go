@@ -0,0 +1,54 @@
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/shopspring/decimal"
+)
+
+func TestOutdated(t *testing.T) {
+	productID, otherProductID := uint(7), uint(8)
+	name := "Umrah Reguler"
+	date := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	newDate := date.AddDate(0, 1, 0)
+
+	newBooking := func() *model.Booking {
+		return &model.Booking{
+			ID:             1,
+			ProductID:      &productID,
+			ProductName:    &name,
+			Date:           &date,
+			TotalQty:       2,
+			TotalAmount:    decimal.NewFromInt(45000000),
+			DiscountAmount: decimal.NewFromInt(5000000),
+		}
+	}
+	inv := buildInvoice(newBooking(), decimal.NewFromInt(11))
+
+	tests := []struct {
+		name   string
+		change func(b *model.Booking)
+		want   bool
+	}{
+		{"unchanged", func(b *model.Booking) {}, false},
+		{"product id copied", func(b *model.Booking) { id := productID; b.ProductID = &id }, false},
+		{"total amount", func(b *model.Booking) { b.TotalAmount = decimal.NewFromInt(40000000) }, true},
+		{"discount amount", func(b *model.Booking) { b.DiscountAmount = decimal.Zero }, true},
+		{"quantity", func(b *model.Booking) { b.TotalQty = 3 }, true},
+		{"departure date", func(b *model.Booking) { b.Date = &newDate }, true},
+		{"product", func(b *model.Booking) { b.ProductID = &otherProductID }, true},
+		{"status only", func(b *model.Booking) { b.Status = model.BookingStatusCanceled }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBooking()
+			tt.change(b)
+			if got := outdated(inv, b); got != tt.want {
+				t.Errorf("outdated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
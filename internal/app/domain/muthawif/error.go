@@ -0,0 +1,57 @@
+package muthawif
+
+import "github.com/aburizalpurnama/travel/internal/pkg/apperror"
+
+// ==========================================================
+// Muthawif Error Constructors
+// ==========================================================
+
+// ErrMuthawifAssignmentNotFound creates a new error for missing muthawif assignment records.
+func ErrMuthawifAssignmentNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"muthawif assignment not found",
+		err,
+		nil,
+	)
+}
+
+// ErrMuthawifNotFound creates a new error for users that do not exist or are not muthawif.
+func ErrMuthawifNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"muthawif not found",
+		err,
+		nil,
+	)
+}
+
+// ErrNotMuthawif creates a new error for assigning a user that is not an active muthawif.
+func ErrNotMuthawif(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"user is not an active muthawif",
+		err,
+		map[string]any{"muthawif_id": apperror.InvalidValue},
+	)
+}
+
+// ErrMuthawifUnavailable creates a new error for assigning a muthawif to a trip overlapping another of their trips.
+func ErrMuthawifUnavailable(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"muthawif is already assigned to a trip overlapping these dates",
+		err,
+		nil,
+	)
+}
+
+// ErrTripsForbidden creates a new error for viewing the trips of another muthawif.
+func ErrTripsForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"muthawif can only view their own trips",
+		nil,
+		nil,
+	)
+}
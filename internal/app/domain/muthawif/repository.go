@@ -0,0 +1,99 @@
+package muthawif
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/paginator"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("muthawif.repository")
+
+// Repository implements the contract.MuthawifAssignmentRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.MuthawifAssignment, model.MuthawifAssignmentFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new muthawif assignment repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.MuthawifAssignment, model.MuthawifAssignmentFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.MuthawifAssignmentRepository = (*Repository)(nil)
+
+// FindTrips retrieves the active assignments of a muthawif returning on or after the given date,
+// joined with their departure batch and product, ordered by departure date.
+func (r *Repository) FindTrips(ctx context.Context, muthawifID uint, from time.Time, page *int, size *int) ([]model.MuthawifTrip, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindTrips")
+	defer span.End()
+
+	query := r.trips(ctx, muthawifID, from).
+		Select(`a.*, b.product_id, p.name AS product_name, b.quota, b.seats_taken`).
+		Order("a.departure_date ASC, a.id ASC")
+
+	if page != nil && size != nil {
+		query = query.Offset(paginator.GetOffset(*page, *size)).Limit(*size)
+	}
+
+	var data []model.MuthawifTrip
+	err := query.Scan(&data).Error
+	return data, err
+}
+
+// CountTrips returns the number of active assignments of a muthawif returning on or after the given date.
+func (r *Repository) CountTrips(ctx context.Context, muthawifID uint, from time.Time) (int64, error) {
+	ctx, span := repositoryTracer.Start(ctx, "CountTrips")
+	defer span.End()
+
+	var count int64
+	err := r.trips(ctx, muthawifID, from).Count(&count).Error
+	return count, err
+}
+
+// trips builds the base query shared by FindTrips and CountTrips.
+func (r *Repository) trips(ctx context.Context, muthawifID uint, from time.Time) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table(`"core"."muthawif_assignments" a`).
+		Joins(`JOIN "core"."departure_batches" b ON b.id = a.departure_batch_id`).
+		Joins(`JOIN "core"."products" p ON p.id = b.product_id`).
+		Where("a.deleted_on IS NULL AND b.deleted_on IS NULL").
+		Where("a.muthawif_id = ? AND a.return_date >= ?", muthawifID, from.Format(time.DateOnly))
+}
+
+// SyncBatchDates copies the dates of a departure batch to its active assignments.
+// It fails with an exclusion violation when the new dates overlap another trip of an assigned muthawif.
+func (r *Repository) SyncBatchDates(ctx context.Context, batchID uint, departureDate time.Time, returnDate time.Time) error {
+	ctx, span := repositoryTracer.Start(ctx, "SyncBatchDates")
+	defer span.End()
+
+	return r.db.WithContext(ctx).
+		Model(&model.MuthawifAssignment{}).
+		Where("deleted_on IS NULL AND departure_batch_id = ?", batchID).
+		Updates(map[string]any{
+			"departure_date": departureDate,
+			"return_date":    returnDate,
+		}).Error
+}
+
+// DeleteByDepartureBatchID soft deletes the active assignments of a departure batch.
+func (r *Repository) DeleteByDepartureBatchID(ctx context.Context, batchID uint) error {
+	ctx, span := repositoryTracer.Start(ctx, "DeleteByDepartureBatchID")
+	defer span.End()
+
+	return r.db.WithContext(ctx).
+		Model(&model.MuthawifAssignment{}).
+		Where("deleted_on IS NULL AND departure_batch_id = ?", batchID).
+		Update("deleted_on", time.Now()).Error
+}
@@ -0,0 +1,16 @@
+package muthawif
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers muthawif assignment and trip routes to the provided router group.
+// Assigning and unassigning muthawifs requires the muthawif:assign permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	router.Post("/departure-batches/:id/muthawifs", authz.Require(rbac.MuthawifAssign), handler.AssignMuthawif)
+	router.Get("/departure-batches/:id/muthawifs", handler.GetDepartureBatchMuthawifs)
+	router.Delete("/muthawif-assignments/:id", authz.Require(rbac.MuthawifAssign), handler.UnassignMuthawif)
+	router.Get("/muthawifs/:id/trips", handler.GetMuthawifTrips)
+}
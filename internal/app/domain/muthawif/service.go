@@ -0,0 +1,252 @@
+package muthawif
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/departure"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("muthawif.service")
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+}
+
+// NewService initializes a new instance of muthawif service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper) *service {
+	return &service{uow: uow, mapper: mapper}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.MuthawifService = (*service)(nil)
+
+// AssignMuthawif assigns an active muthawif to a departure batch.
+// The batch is locked so its dates cannot change while they are copied to the assignment, and the
+// database rejects the assignment when it overlaps another trip of the same muthawif.
+func (s *service) AssignMuthawif(ctx context.Context, batchID uint, req payload.MuthawifAssignmentCreateRequest) (*payload.MuthawifAssignmentResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "AssignMuthawif")
+	defer span.End()
+
+	var created *model.MuthawifAssignment
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		batch, err := uow.DepartureBatchRepository().FindByIDForUpdate(ctx, batchID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return departure.ErrDepartureBatchNotFound(err)
+			}
+
+			return err
+		}
+
+		u, err := uow.UserRepository().FindByID(ctx, req.MuthawifID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotMuthawif(err)
+			}
+
+			return err
+		}
+
+		if u.Role != model.UserRoleMuthawif || (u.IsActive != nil && !*u.IsActive) {
+			return ErrNotMuthawif(nil)
+		}
+
+		created, err = uow.MuthawifAssignmentRepository().Save(ctx, &model.MuthawifAssignment{
+			DepartureBatchID: batch.ID,
+			MuthawifID:       u.ID,
+			DepartureDate:    batch.DepartureDate,
+			ReturnDate:       batch.ReturnDate,
+			Notes:            req.Notes,
+			CreatedBy:        actor.FromContext(ctx).JSON(),
+		})
+		if dberror.GetSQLState(err) == dberror.ExclusionViolation {
+			return ErrMuthawifUnavailable(err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.MuthawifAssignmentResponse
+	err = s.mapper.ToResponse(created, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetDepartureBatchMuthawifs retrieves the muthawif assigned to a departure batch.
+func (s *service) GetDepartureBatchMuthawifs(ctx context.Context, batchID uint) ([]payload.MuthawifAssignmentResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetDepartureBatchMuthawifs")
+	defer span.End()
+
+	_, err := s.uow.DepartureBatchRepository().FindByID(ctx, batchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, departure.ErrDepartureBatchNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	assignments, err := s.uow.MuthawifAssignmentRepository().FindAll(ctx, nil, nil, &model.MuthawifAssignmentFilter{
+		DepartureBatchID: &batchID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := []payload.MuthawifAssignmentResponse{}
+	err = s.mapper.ToResponse(assignments, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// UnassignMuthawif removes a muthawif assignment, freeing the muthawif for other trips on those dates.
+func (s *service) UnassignMuthawif(ctx context.Context, id uint) error {
+	ctx, span := serviceTracer.Start(ctx, "UnassignMuthawif")
+	defer span.End()
+
+	_, err := s.uow.MuthawifAssignmentRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMuthawifAssignmentNotFound(err)
+		}
+
+		return err
+	}
+
+	return s.uow.MuthawifAssignmentRepository().Delete(ctx, id)
+}
+
+// GetMuthawifTrips retrieves the trips of a muthawif that have not ended yet, soonest first,
+// each with the bookings holding seats on it. A muthawif can only view their own trips.
+func (s *service) GetMuthawifTrips(ctx context.Context, muthawifID uint, req payload.MuthawifTripGetAllRequest) ([]payload.MuthawifTripResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetMuthawifTrips")
+	defer span.End()
+
+	err := authorizeTrips(ctx, muthawifID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := s.uow.UserRepository().FindByID(ctx, muthawifID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrMuthawifNotFound(err)
+		}
+
+		return nil, nil, err
+	}
+	if u.Role != model.UserRoleMuthawif {
+		return nil, nil, ErrMuthawifNotFound(nil)
+	}
+
+	today := time.Now()
+
+	var count int64
+	var trips []model.MuthawifTrip
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.MuthawifAssignmentRepository().CountTrips(groupCtx, muthawifID, today)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		trips, err = s.uow.MuthawifAssignmentRepository().FindTrips(groupCtx, muthawifID, today, req.Page, req.Size)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := make([]payload.MuthawifTripResponse, 0, len(trips))
+	if len(trips) == 0 {
+		return resp, response.NewPagination(req.Page, req.Size, &count), nil
+	}
+
+	batchIDs := make([]uint, 0, len(trips))
+	for _, trip := range trips {
+		batchIDs = append(batchIDs, trip.DepartureBatchID)
+	}
+
+	bookings, err := s.uow.BookingRepository().FindActiveByDepartureBatchIDs(ctx, batchIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bookingsByBatch := make(map[uint][]payload.MuthawifTripBookingResponse, len(trips))
+	for _, b := range bookings {
+		bookingsByBatch[*b.DepartureBatchID] = append(bookingsByBatch[*b.DepartureBatchID], payload.MuthawifTripBookingResponse{
+			ID:           b.ID,
+			Code:         b.Code,
+			UserID:       b.UserID,
+			UserFullName: b.UserFullName,
+			TotalQty:     b.TotalQty,
+			Status:       string(b.Status),
+		})
+	}
+
+	for _, trip := range trips {
+		tripBookings := bookingsByBatch[trip.DepartureBatchID]
+		if tripBookings == nil {
+			tripBookings = []payload.MuthawifTripBookingResponse{}
+		}
+
+		resp = append(resp, payload.MuthawifTripResponse{
+			AssignmentID:     trip.ID,
+			DepartureBatchID: trip.DepartureBatchID,
+			ProductID:        trip.ProductID,
+			ProductName:      trip.ProductName,
+			DepartureDate:    trip.DepartureDate,
+			ReturnDate:       trip.ReturnDate,
+			Quota:            trip.Quota,
+			SeatsTaken:       trip.SeatsTaken,
+			Notes:            trip.Notes,
+			Bookings:         tripBookings,
+		})
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// authorizeTrips checks that the actor may view the trips of the given muthawif.
+// A muthawif only sees their own trips and customers see none; staff and the SYSTEM actor see all.
+func authorizeTrips(ctx context.Context, muthawifID uint) error {
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.UserRoleMuthawif:
+		if a.ID != muthawifID {
+			return ErrTripsForbidden()
+		}
+	case model.UserRoleCustomer, model.AdminRoleAgent, model.AdminRoleFinInst:
+		return ErrTripsForbidden()
+	}
+
+	return nil
+}
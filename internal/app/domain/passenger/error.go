@@ -0,0 +1,62 @@
+package passenger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Passenger Error Constructors
+// ==========================================================
+
+// ErrInvalidValue creates a new error for passenger fields that cannot be parsed or are out of range.
+func ErrInvalidValue(field string, err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		err,
+		map[string]any{field: apperror.InvalidValue},
+	)
+}
+
+// ErrPassengerCountMismatch creates a new error for manifests whose size differs from the booking quantity.
+func ErrPassengerCountMismatch(totalQty, count int) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		fmt.Sprintf("booking is for %d passengers but %d were given", totalQty, count),
+		nil,
+		map[string]any{"passengers": apperror.InvalidLength},
+	)
+}
+
+// ErrDuplicatePassport creates a new error for a passport number listed more than once in a manifest.
+func ErrDuplicatePassport(index int) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"passport number is listed more than once",
+		nil,
+		map[string]any{fmt.Sprintf("passengers[%d].passport_number", index): apperror.InvalidValue},
+	)
+}
+
+// ErrMultipleLeadBookers creates a new error for manifests with more than one passenger related as self.
+func ErrMultipleLeadBookers(index int) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"only one passenger can be the lead booker",
+		nil,
+		map[string]any{fmt.Sprintf("passengers[%d].relationship", index): apperror.InvalidChoice},
+	)
+}
+
+// ErrPassportExpiresTooSoon creates a new error for passports that are not valid long enough after departure.
+func ErrPassportExpiresTooSoon(index int, minExpiry time.Time) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"passport must be valid until at least "+minExpiry.Format(time.DateOnly),
+		nil,
+		map[string]any{fmt.Sprintf("passengers[%d].passport_expiry", index): apperror.ValueTooLow},
+	)
+}
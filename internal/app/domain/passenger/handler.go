@@ -0,0 +1,100 @@
+package passenger
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("passenger.handler")
+
+type Handler struct {
+	service contract.BookingPassengerService
+}
+
+// NewHandler initializes a new instance of PassengerHandler.
+func NewHandler(service contract.BookingPassengerService) *Handler {
+	return &Handler{service: service}
+}
+
+// ReplaceBookingPassengers handles replacing the passenger manifest of a booking.
+func (h *Handler) ReplaceBookingPassengers(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "ReplaceBookingPassengers")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.BookingPassengerReplaceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	manifest, err := h.service.ReplaceBookingPassengers(ctx, uint(bookingID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(manifest, nil))
+}
+
+// GetBookingPassengers retrieves the passenger manifest of a booking.
+func (h *Handler) GetBookingPassengers(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookingPassengers")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	manifest, err := h.service.GetBookingPassengers(ctx, uint(bookingID))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(manifest, nil))
+}
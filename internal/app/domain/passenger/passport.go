@@ -0,0 +1,29 @@
+package passenger
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// passportValidityMonths is the number of months a passport must remain valid after departure.
+const passportValidityMonths = 6
+
+// MinPassportExpiry returns the earliest passport expiry date accepted for the given departure date.
+func MinPassportExpiry(departureDate time.Time) time.Time {
+	d := time.Date(departureDate.Year(), departureDate.Month(), departureDate.Day(), 0, 0, 0, 0, time.UTC)
+	return d.AddDate(0, passportValidityMonths, 0)
+}
+
+// CheckPassports verifies that every passenger's passport is valid long enough after the departure date.
+// It returns an error pointing at the first passenger that fails the check.
+func CheckPassports(passengers []model.BookingPassenger, departureDate time.Time) error {
+	minExpiry := MinPassportExpiry(departureDate)
+	for i, p := range passengers {
+		if p.PassportExpiry.Before(minExpiry) {
+			return ErrPassportExpiresTooSoon(i, minExpiry)
+		}
+	}
+
+	return nil
+}
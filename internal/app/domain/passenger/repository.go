@@ -0,0 +1,61 @@
+package passenger
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("passenger.repository")
+
+// Repository implements the contract.BookingPassengerRepository interface.
+type Repository struct {
+	db *gorm.DB
+}
+
+// NewRepository creates a new booking passenger repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.BookingPassengerRepository = (*Repository)(nil)
+
+// FindByBookingID retrieves the active passengers of a booking in the order they were listed.
+func (r *Repository) FindByBookingID(ctx context.Context, bookingID uint) ([]model.BookingPassenger, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByBookingID")
+	defer span.End()
+
+	var data []model.BookingPassenger
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND booking_id = ?", bookingID).
+		Order("id ASC").
+		Find(&data).Error
+	return data, err
+}
+
+// ReplaceByBookingID soft deletes the active passengers of a booking and inserts the given ones in a single statement.
+// It must be called within a transaction so the manifest is never observed half replaced.
+func (r *Repository) ReplaceByBookingID(ctx context.Context, bookingID uint, passengers []model.BookingPassenger) error {
+	ctx, span := repositoryTracer.Start(ctx, "ReplaceByBookingID")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).
+		Model(&model.BookingPassenger{}).
+		Where("deleted_on IS NULL AND booking_id = ?", bookingID).
+		Update("deleted_on", time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	if len(passengers) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Create(&passengers).Error
+}
@@ -0,0 +1,9 @@
+package passenger
+
+import "github.com/gofiber/fiber/v2"
+
+// NewRoute registers passenger manifest routes to the provided router group.
+func NewRoute(router fiber.Router, handler *Handler) {
+	router.Put("/bookings/:id/passengers", handler.ReplaceBookingPassengers)
+	router.Get("/bookings/:id/passengers", handler.GetBookingPassengers)
+}
@@ -0,0 +1,194 @@
+package passenger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("passenger.service")
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+}
+
+// NewService initializes a new instance of passenger service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper) *service {
+	return &service{uow: uow, mapper: mapper}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.BookingPassengerService = (*service)(nil)
+
+// ReplaceBookingPassengers replaces the passenger manifest of a booking as a whole.
+// The manifest must list exactly as many passengers as the booking quantity, and every passport
+// must remain valid for at least six months after the departure date.
+// Customers can only manage the passengers of their own bookings.
+func (s *service) ReplaceBookingPassengers(ctx context.Context, bookingID uint, req payload.BookingPassengerReplaceRequest) (*payload.BookingPassengerManifestResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ReplaceBookingPassengers")
+	defer span.End()
+
+	passengers, err := toModels(req.Passengers)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *model.Booking
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		b, err = uow.BookingRepository().FindByIDForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		err = booking.Authorize(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		if b.Status != model.BookingStatusBooked && b.Status != model.BookingStatusConfirmed {
+			return booking.ErrBookingNotEditable(b.Status)
+		}
+
+		if len(passengers) != b.TotalQty {
+			return ErrPassengerCountMismatch(b.TotalQty, len(passengers))
+		}
+
+		err = CheckPassports(passengers, departureDate(b))
+		if err != nil {
+			return err
+		}
+
+		by := actor.FromContext(ctx).JSON()
+		for i := range passengers {
+			passengers[i].BookingID = b.ID
+			passengers[i].CreatedBy = by
+		}
+
+		return uow.BookingPassengerRepository().ReplaceByBookingID(ctx, b.ID, passengers)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toManifestResponse(b, passengers)
+}
+
+// GetBookingPassengers retrieves the passenger manifest of a booking.
+// Only staff can view the passengers of any booking.
+func (s *service) GetBookingPassengers(ctx context.Context, bookingID uint) (*payload.BookingPassengerManifestResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingPassengers")
+	defer span.End()
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = booking.Authorize(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	passengers, err := s.uow.BookingPassengerRepository().FindByBookingID(ctx, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toManifestResponse(b, passengers)
+}
+
+// toManifestResponse maps a booking and its passengers to the manifest response DTO.
+func (s *service) toManifestResponse(b *model.Booking, passengers []model.BookingPassenger) (*payload.BookingPassengerManifestResponse, error) {
+	resp := payload.BookingPassengerManifestResponse{
+		BookingID:  b.ID,
+		TotalQty:   b.TotalQty,
+		IsComplete: len(passengers) == b.TotalQty,
+		Passengers: []payload.BookingPassengerResponse{},
+	}
+
+	err := s.mapper.ToResponse(passengers, &resp.Passengers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// toModels parses and normalizes the passenger entries of a manifest request.
+// Passport numbers are compared case-insensitively, and at most one passenger may be the lead booker.
+func toModels(reqs []payload.BookingPassengerRequest) ([]model.BookingPassenger, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	passports := make(map[string]struct{}, len(reqs))
+	hasSelf := false
+
+	passengers := make([]model.BookingPassenger, 0, len(reqs))
+	for i, req := range reqs {
+		dateOfBirth, err := time.Parse(time.DateOnly, req.DateOfBirth)
+		if err != nil {
+			return nil, ErrInvalidValue(fmt.Sprintf("passengers[%d].date_of_birth", i), err)
+		}
+		if !dateOfBirth.Before(today) {
+			return nil, ErrInvalidValue(fmt.Sprintf("passengers[%d].date_of_birth", i), nil)
+		}
+
+		passportExpiry, err := time.Parse(time.DateOnly, req.PassportExpiry)
+		if err != nil {
+			return nil, ErrInvalidValue(fmt.Sprintf("passengers[%d].passport_expiry", i), err)
+		}
+
+		passportNumber := strings.ToUpper(strings.TrimSpace(req.PassportNumber))
+		if _, ok := passports[passportNumber]; ok {
+			return nil, ErrDuplicatePassport(i)
+		}
+		passports[passportNumber] = struct{}{}
+
+		relationship := model.PassengerRelationship(req.Relationship)
+		if relationship == model.PassengerRelationshipSelf {
+			if hasSelf {
+				return nil, ErrMultipleLeadBookers(i)
+			}
+			hasSelf = true
+		}
+
+		passengers = append(passengers, model.BookingPassenger{
+			FullName:       strings.TrimSpace(req.FullName),
+			Gender:         req.Gender,
+			DateOfBirth:    dateOfBirth,
+			PassportNumber: passportNumber,
+			PassportExpiry: passportExpiry,
+			Relationship:   relationship,
+		})
+	}
+
+	return passengers, nil
+}
+
+// departureDate returns the travel date of a booking, which follows its departure batch when it has one.
+func departureDate(b *model.Booking) time.Time {
+	if b.Date != nil {
+		return *b.Date
+	}
+
+	return time.Now()
+}
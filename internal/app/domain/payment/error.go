@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Payment Error Constructors
+// ==========================================================
+
+// ErrPaymentNotFound creates a new error for missing payment records.
+func ErrPaymentNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"payment not found",
+		err,
+		nil,
+	)
+}
+
+// ErrInvalidAmount creates a new error for payment amounts that are not a positive number.
+func ErrInvalidAmount(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		err,
+		map[string]any{"amount": apperror.InvalidValue},
+	)
+}
+
+// ErrOverpayment creates a new error for payments exceeding the outstanding balance of a booking.
+func ErrOverpayment(outstanding string) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"payment amount exceeds the outstanding balance of "+outstanding,
+		nil,
+		map[string]any{"amount": apperror.ValueTooHigh},
+	)
+}
+
+// ErrBookingNotPayable creates a new error for payments against a booking whose status does not accept payments.
+func ErrBookingNotPayable(status model.BookingStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking does not accept payments in its current status",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrPaymentForbidden creates a new error for actors recording a payment they are not allowed to record.
+func ErrPaymentForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"customers can only pay their own bookings from their wallet",
+		nil,
+		nil,
+	)
+}
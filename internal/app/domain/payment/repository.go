@@ -0,0 +1,47 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("payment.repository")
+
+// Repository implements the contract.PaymentRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Payment, model.PaymentFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new payment repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Payment, model.PaymentFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.PaymentRepository = (*Repository)(nil)
+
+// SumAmountByBookingID returns the total amount of all payments recorded for a booking.
+func (r *Repository) SumAmountByBookingID(ctx context.Context, bookingID uint) (decimal.Decimal, error) {
+	ctx, span := repositoryTracer.Start(ctx, "SumAmountByBookingID")
+	defer span.End()
+
+	var total decimal.Decimal
+	err := r.db.WithContext(ctx).
+		Model(&model.Payment{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("deleted_on IS NULL AND booking_id = ?", bookingID).
+		Scan(&total).Error
+	return total, err
+}
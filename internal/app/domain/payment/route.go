@@ -0,0 +1,17 @@
+package payment
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers payment-related routes to the provided router group.
+// Recording a payment requires the payment:record permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	router.Post("/bookings/:id/payments", authz.Require(rbac.PaymentRecord), handler.RecordPayment)
+	router.Get("/bookings/:id/payments", handler.GetBookingPayments)
+
+	payments := router.Group("/payments")
+	payments.Get("/:id", handler.GetPayment)
+}
@@ -0,0 +1,39 @@
+package payment
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/shopspring/decimal"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+// DownPaymentRule defines the minimum amount a booking must have paid to be considered down-paid.
+// The threshold is the greater of MinPercent of the total amount and MinAmount, capped at the total amount.
+type DownPaymentRule struct {
+	MinPercent decimal.Decimal
+	MinAmount  decimal.Decimal
+}
+
+// Threshold returns the amount that must be paid for a booking of the given total to reach the dp status.
+func (r DownPaymentRule) Threshold(totalAmount decimal.Decimal) decimal.Decimal {
+	threshold := totalAmount.Mul(r.MinPercent).Div(hundred).Round(2)
+	if r.MinAmount.GreaterThan(threshold) {
+		threshold = r.MinAmount
+	}
+	if threshold.GreaterThan(totalAmount) {
+		threshold = totalAmount
+	}
+	return threshold
+}
+
+// PaymentStatus derives the payment status of a booking from its total amount and the amount paid so far.
+func (r DownPaymentRule) PaymentStatus(totalAmount, totalPayment decimal.Decimal) model.PaymentStatus {
+	switch {
+	case totalPayment.GreaterThanOrEqual(totalAmount):
+		return model.PaymentStatusPaid
+	case totalPayment.IsPositive() && totalPayment.GreaterThanOrEqual(r.Threshold(totalAmount)):
+		return model.PaymentStatusDP
+	default:
+		return model.PaymentStatusUnpaid
+	}
+}
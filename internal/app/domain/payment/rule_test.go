@@ -0,0 +1,63 @@
+package payment
+
+import (
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/shopspring/decimal"
+)
+
+func TestDownPaymentRuleThreshold(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  DownPaymentRule
+		total string
+		want  string
+	}{
+		{"percent of total", DownPaymentRule{MinPercent: decimal.NewFromInt(30)}, "1000000", "300000"},
+		{"percent rounded to cents", DownPaymentRule{MinPercent: decimal.NewFromInt(30)}, "100.05", "30.02"},
+		{"minimum amount above percent", DownPaymentRule{MinPercent: decimal.NewFromInt(10), MinAmount: decimal.NewFromInt(500000)}, "1000000", "500000"},
+		{"percent above minimum amount", DownPaymentRule{MinPercent: decimal.NewFromInt(60), MinAmount: decimal.NewFromInt(500000)}, "1000000", "600000"},
+		{"capped at total", DownPaymentRule{MinAmount: decimal.NewFromInt(500000)}, "200000", "200000"},
+		{"no rule", DownPaymentRule{}, "1000000", "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule.Threshold(decimal.RequireFromString(tt.total))
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("Threshold(%s) = %s, want %s", tt.total, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDownPaymentRulePaymentStatus(t *testing.T) {
+	rule := DownPaymentRule{MinPercent: decimal.NewFromInt(30), MinAmount: decimal.NewFromInt(100)}
+
+	tests := []struct {
+		name    string
+		total   string
+		payment string
+		want    model.PaymentStatus
+	}{
+		{"nothing paid", "1000", "0", model.PaymentStatusUnpaid},
+		{"below threshold", "1000", "299.99", model.PaymentStatusUnpaid},
+		{"at threshold", "1000", "300", model.PaymentStatusDP},
+		{"between threshold and total", "1000", "999.99", model.PaymentStatusDP},
+		{"fully paid", "1000", "1000", model.PaymentStatusPaid},
+		{"minimum amount applies", "200", "60", model.PaymentStatusUnpaid},
+		{"minimum amount reached", "200", "100", model.PaymentStatusDP},
+		{"threshold capped at total", "50", "50", model.PaymentStatusPaid},
+		{"free booking", "0", "0", model.PaymentStatusPaid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rule.PaymentStatus(decimal.RequireFromString(tt.total), decimal.RequireFromString(tt.payment))
+			if got != tt.want {
+				t.Errorf("PaymentStatus(%s, %s) = %s, want %s", tt.total, tt.payment, got, tt.want)
+			}
+		})
+	}
+}
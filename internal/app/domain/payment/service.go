@@ -0,0 +1,300 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/agent"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/domain/installment"
+	"github.com/aburizalpurnama/travel/internal/app/domain/referral"
+	"github.com/aburizalpurnama/travel/internal/app/domain/wallet"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("payment.service")
+
+type service struct {
+	uow          contract.UnitOfWork
+	mapper       contract.Mapper
+	rule         DownPaymentRule
+	referralRule referral.RewardRule
+}
+
+// NewService initializes a new instance of payment service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, rule DownPaymentRule, referralRule referral.RewardRule) *service {
+	return &service{uow: uow, mapper: mapper, rule: rule, referralRule: referralRule}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.PaymentService = (*service)(nil)
+
+// payableStatuses lists the booking statuses that still accept payments.
+var payableStatuses = map[model.BookingStatus]bool{
+	model.BookingStatusBooked:    true,
+	model.BookingStatusConfirmed: true,
+	model.BookingStatusOnTrip:    true,
+}
+
+// RecordPayment records a payment against a booking.
+// The booking row is locked for the duration of the transaction so concurrent payments are applied one at a time.
+func (s *service) RecordPayment(ctx context.Context, bookingID uint, req payload.PaymentCreateRequest) (*payload.PaymentRecordResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RecordPayment")
+	defer span.End()
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, ErrInvalidAmount(err)
+	}
+	if !amount.IsPositive() {
+		return nil, ErrInvalidAmount(nil)
+	}
+
+	paidAt := time.Now()
+	if req.PaidAt != nil {
+		paidAt = *req.PaidAt
+	}
+
+	var created *model.Payment
+	var updated *model.Booking
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		err = authorizePayment(ctx, b, req.Method)
+		if err != nil {
+			return err
+		}
+
+		created, updated, err = Apply(ctx, uow, b, &model.Payment{
+			Amount:    amount,
+			Method:    req.Method,
+			Reference: req.Reference,
+			PaidAt:    paidAt,
+			Notes:     req.Notes,
+		}, s.rule, s.referralRule)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.PaymentRecordResponse
+	err = s.mapper.ToResponse(created, &resp.Payment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.mapper.ToResponse(updated, &resp.Booking)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetBookingPayments retrieves the payments of a booking with support for pagination.
+// Only staff can view the payments of any booking.
+func (s *service) GetBookingPayments(ctx context.Context, bookingID uint, req payload.PaymentGetAllRequest) ([]payload.PaymentBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingPayments")
+	defer span.End()
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, nil, err
+	}
+
+	err = booking.Authorize(ctx, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.PaymentFilter == nil {
+		req.PaymentFilter = &model.PaymentFilter{}
+	}
+	req.BookingID = &bookingID
+
+	var count int64
+	var payments []model.Payment
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.PaymentRepository().Count(groupCtx, req.PaymentFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		payments, err = s.uow.PaymentRepository().FindAll(groupCtx, req.Page, req.Size, req.PaymentFilter)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp []payload.PaymentBaseResponse
+	err = s.mapper.ToResponse(payments, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetPaymentByID retrieves a specific payment by its unique identifier.
+// Only staff can view any payment; others only the payments of their own bookings.
+func (s *service) GetPaymentByID(ctx context.Context, id uint) (*payload.PaymentBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetPaymentByID")
+	defer span.End()
+
+	payment, err := s.uow.PaymentRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPaymentNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, payment.BookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = booking.Authorize(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.PaymentBaseResponse
+	err = s.mapper.ToResponse(payment, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// authorizePayment checks that the actor may record a payment of the given method against the booking.
+// Customers can only pay their own bookings from their wallet, since other methods are recorded by staff
+// once the money has been received; partners record none. Staff and the SYSTEM actor may record any.
+func authorizePayment(ctx context.Context, b *model.Booking, method string) error {
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.UserRoleCustomer:
+		if a.ID != b.UserID || method != model.PaymentMethodWallet {
+			return ErrPaymentForbidden()
+		}
+	case model.UserRoleMuthawif, model.AdminRoleAgent, model.AdminRoleFinInst:
+		return ErrPaymentForbidden()
+	}
+
+	return nil
+}
+
+// Reconcile recalculates the total payment of a locked booking from its payment ledger and
+// derives the payment status from the given rule. It must be called within a transaction.
+func Reconcile(ctx context.Context, uow contract.UnitOfWork, b *model.Booking, rule DownPaymentRule) (*model.Booking, error) {
+	total, err := uow.PaymentRepository().SumAmountByBookingID(ctx, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	b.TotalPayment = total
+	b.PaymentStatus = rule.PaymentStatus(b.TotalAmount, total)
+	b.ModifiedOn = &now
+	b.ModifiedBy = actor.FromContext(ctx).JSON()
+
+	return uow.BookingRepository().Update(ctx, b)
+}
+
+// Apply records a payment against a booking that is locked for update and brings the booking up to date.
+// The booking's total payment is recalculated from the ledger and its payment status derived from the down-payment rule.
+// Wallet payments are debited from the booker's wallet and fail with InsufficientFunds when the balance is short.
+// When the booking becomes paid, the referrer's reward and the agent's commission, if any, are recorded as well.
+// It must be called within a transaction.
+func Apply(ctx context.Context, uow contract.UnitOfWork, b *model.Booking, p *model.Payment, rule DownPaymentRule, referralRule referral.RewardRule) (*model.Payment, *model.Booking, error) {
+	if !payableStatuses[b.Status] {
+		return nil, nil, ErrBookingNotPayable(b.Status)
+	}
+
+	outstanding := b.TotalAmount.Sub(b.TotalPayment)
+	if p.Amount.GreaterThan(outstanding) {
+		return nil, nil, ErrOverpayment(outstanding.StringFixed(2))
+	}
+
+	p.BookingID = b.ID
+	p.CreatedBy = actor.FromContext(ctx).JSON()
+
+	created, err := uow.PaymentRepository().Save(ctx, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Wallet payments are taken from the booker's wallet balance
+	if p.Method == model.PaymentMethodWallet {
+		_, err = wallet.Debit(ctx, uow, b.UserID, wallet.Posting{
+			Type:      model.WalletTransactionPayment,
+			Amount:    p.Amount,
+			BookingID: &b.ID,
+			PaymentID: &created.ID,
+			Reference: &b.Code,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Settle installment dues, if any, starting from the oldest one
+	err = installment.AllocatePayment(ctx, uow, b.ID, p.Amount, p.PaidAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated, err := Reconcile(ctx, uow, b, rule)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = referral.Reward(ctx, uow, updated, referralRule)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = agent.Earn(ctx, uow, updated)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, updated, nil
+}
@@ -0,0 +1,44 @@
+package payment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+)
+
+func TestAuthorizePayment(t *testing.T) {
+	b := &model.Booking{ID: 1, UserID: 7}
+
+	tests := []struct {
+		name    string
+		actor   *actor.Actor
+		method  string
+		allowed bool
+	}{
+		{"owner pays from wallet", &actor.Actor{ID: 7, Role: model.UserRoleCustomer}, model.PaymentMethodWallet, true},
+		{"owner records cash", &actor.Actor{ID: 7, Role: model.UserRoleCustomer}, model.PaymentMethodCash, false},
+		{"owner records bank transfer", &actor.Actor{ID: 7, Role: model.UserRoleCustomer}, model.PaymentMethodBankTransfer, false},
+		{"other customer pays from wallet", &actor.Actor{ID: 8, Role: model.UserRoleCustomer}, model.PaymentMethodWallet, false},
+		{"muthawif", &actor.Actor{ID: 7, Role: model.UserRoleMuthawif}, model.PaymentMethodWallet, false},
+		{"agent", &actor.Actor{ID: 2, Role: model.AdminRoleAgent}, model.PaymentMethodCash, false},
+		{"financing institution", &actor.Actor{ID: 3, Role: model.AdminRoleFinInst}, model.PaymentMethodBankTransfer, false},
+		{"admin records cash", &actor.Actor{ID: 1, Role: model.AdminRoleAdmin}, model.PaymentMethodCash, true},
+		{"system", nil, model.PaymentMethodBankTransfer, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.actor != nil {
+				ctx = actor.NewContext(ctx, *tt.actor)
+			}
+
+			err := authorizePayment(ctx, b, tt.method)
+			if (err == nil) != tt.allowed {
+				t.Errorf("authorizePayment() error = %v, want allowed %v", err, tt.allowed)
+			}
+		})
+	}
+}
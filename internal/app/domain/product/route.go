@@ -1,14 +1,19 @@
 package product
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
 
 // NewRoute registers product-related routes to the provided router group.
-func NewRoute(router fiber.Router, handler *Handler) {
+// Creating, updating and deleting products requires the product:write permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
 	products := router.Group("/products")
 
-	products.Post("/", handler.CreateProduct)
+	products.Post("/", authz.Require(rbac.ProductWrite), handler.CreateProduct)
 	products.Get("/", handler.GetProducts)
 	products.Get("/:id", handler.GetProduct)
-	products.Patch("/:id", handler.UpdateProduct)
-	products.Delete("/:id", handler.DeleteProduct)
+	products.Patch("/:id", authz.Require(rbac.ProductWrite), handler.UpdateProduct)
+	products.Delete("/:id", authz.Require(rbac.ProductWrite), handler.DeleteProduct)
 }
@@ -2,12 +2,13 @@ package product
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/aburizalpurnama/travel/internal/app/contract"
 	"github.com/aburizalpurnama/travel/internal/app/model"
 	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
 	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
 	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
 	"github.com/aburizalpurnama/travel/internal/pkg/response"
@@ -49,14 +50,7 @@ func (s *service) CreateProduct(ctx context.Context, req payload.ProductCreateRe
 		return nil, err
 	}
 
-	// TODO: Retrieve actor from context in production
-	actor := struct {
-		ID   uint   `json:"id"`
-		Name string `json:"name"`
-	}{ID: 1, Name: "John Doe"}
-
-	actorJSON, _ := json.Marshal(actor)
-	product.CreatedBy = actorJSON
+	product.CreatedBy = actor.FromContext(ctx).JSON()
 
 	created, err := s.uow.ProductRepository().Save(ctx, &product)
 	if err != nil {
@@ -166,6 +160,10 @@ func (s *service) UpdateProduct(ctx context.Context, id uint, req payload.Produc
 		return nil, err
 	}
 
+	now := time.Now()
+	product.ModifiedOn = &now
+	product.ModifiedBy = actor.FromContext(ctx).JSON()
+
 	updated, err := s.uow.ProductRepository().Update(ctx, product)
 	if err != nil {
 		return nil, err
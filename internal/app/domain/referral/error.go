@@ -0,0 +1,77 @@
+package referral
+
+import "github.com/aburizalpurnama/travel/internal/pkg/apperror"
+
+// ==========================================================
+// Referral Error Constructors
+// ==========================================================
+
+// ErrReferralCodeNotFound creates a new error for applying a referral code that no user owns.
+func ErrReferralCodeNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"referral code does not exist",
+		err,
+		map[string]any{"code": apperror.InvalidValue},
+	)
+}
+
+// ErrReferralCodeTaken creates a new error for choosing a referral code another user already owns.
+func ErrReferralCodeTaken(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.ReferralExists,
+		"referral code is already taken",
+		err,
+		map[string]any{"code": apperror.InvalidValue},
+	)
+}
+
+// ErrReferralCodeUnavailable creates a new error for when no unique referral code could be generated.
+func ErrReferralCodeUnavailable(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Internal,
+		"failed to generate a unique referral code",
+		err,
+		nil,
+	)
+}
+
+// ErrSelfReferral creates a new error for users applying their own referral code.
+func ErrSelfReferral() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"users cannot apply their own referral code",
+		nil,
+		map[string]any{"code": apperror.InvalidValue},
+	)
+}
+
+// ErrAlreadyReferred creates a new error for users who were already referred by someone.
+func ErrAlreadyReferred() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"user was already referred",
+		nil,
+		nil,
+	)
+}
+
+// ErrReferralCycle creates a new error for users applying the code of someone they referred.
+func ErrReferralCycle() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"users cannot be referred by someone in their own referral tree",
+		nil,
+		nil,
+	)
+}
+
+// ErrNotNewUser creates a new error for users applying a referral code after their first booking.
+func ErrNotNewUser() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"referral codes can only be applied before the first booking",
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,160 @@
+package referral
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/user"
+	"github.com/aburizalpurnama/travel/internal/app/domain/wallet"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// refereeConstraint is the unique index allowing a user to be referred only once.
+const refereeConstraint = "ux_referrals_referee_id_active"
+
+// RewardRule configures the reward a referrer earns when a referred user's first booking is paid.
+type RewardRule struct {
+	// Amount is credited to the referrer's wallet. A zero amount disables rewards.
+	Amount decimal.Decimal
+}
+
+// NormalizeCode returns the stored form of a referral code. Codes are matched case-insensitively.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Apply records that the referee was referred by the owner of the given code.
+// Only users without bookings can be referred, each user at most once, and never by themselves
+// or by anyone in their own referral tree. It must be called within a transaction.
+func Apply(ctx context.Context, uow contract.UnitOfWork, refereeID uint, code string) (*model.Referral, error) {
+	code = NormalizeCode(code)
+
+	referrer, err := uow.UserRepository().FindByReferralCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReferralCodeNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	if referrer.ID == refereeID {
+		return nil, ErrSelfReferral()
+	}
+
+	_, err = uow.UserRepository().FindByID(ctx, refereeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, user.ErrUserNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	bookings, err := uow.BookingRepository().Count(ctx, &model.BookingFilter{UserID: &refereeID})
+	if err != nil {
+		return nil, err
+	}
+	if bookings > 0 {
+		return nil, ErrNotNewUser()
+	}
+
+	err = uow.ReferralRepository().LockTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uow.ReferralRepository().FindByRefereeID(ctx, refereeID)
+	if err == nil {
+		return nil, ErrAlreadyReferred()
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// Walk up from the referrer; meeting the referee means it would end up referring itself
+	ancestorID := referrer.ID
+	for {
+		r, err := uow.ReferralRepository().FindByRefereeID(ctx, ancestorID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if r.ReferrerID == refereeID {
+			return nil, ErrReferralCycle()
+		}
+		ancestorID = r.ReferrerID
+	}
+
+	created, err := uow.ReferralRepository().Save(ctx, &model.Referral{
+		ReferrerID:   referrer.ID,
+		RefereeID:    refereeID,
+		ReferralCode: code,
+		Status:       model.ReferralStatusPending,
+		CreatedBy:    actor.FromContext(ctx).JSON(),
+	})
+	if err != nil {
+		pgErr := dberror.GetError(err)
+		if pgErr != nil && pgErr.Code == dberror.UniqueViolation && pgErr.ConstraintName == refereeConstraint {
+			return nil, ErrAlreadyReferred()
+		}
+
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Reward pays the referral reward of a booking's user once their booking is paid in full.
+// Only the first paid booking counts: later ones find the referral already rewarded.
+// The booking must be locked, and the referral is locked before the referrer's wallet.
+// It must be called within a transaction.
+func Reward(ctx context.Context, uow contract.UnitOfWork, b *model.Booking, rule RewardRule) error {
+	if b.PaymentStatus != model.PaymentStatusPaid || !rule.Amount.IsPositive() {
+		return nil
+	}
+
+	r, err := uow.ReferralRepository().FindByRefereeIDForUpdate(ctx, b.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if r.Status != model.ReferralStatusPending {
+		return nil
+	}
+
+	transaction, err := wallet.Credit(ctx, uow, r.ReferrerID, wallet.Posting{
+		Type:      model.WalletTransactionReferralReward,
+		Amount:    rule.Amount,
+		BookingID: &b.ID,
+		Reference: &b.Code,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	r.Status = model.ReferralStatusRewarded
+	r.RewardAmount = &rule.Amount
+	r.RewardedBookingID = &b.ID
+	r.RewardedOn = &now
+	r.WalletTransactionID = &transaction.ID
+	r.ModifiedOn = &now
+	r.ModifiedBy = actor.FromContext(ctx).JSON()
+
+	_, err = uow.ReferralRepository().Update(ctx, r)
+	return err
+}
@@ -0,0 +1,97 @@
+package referral
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("referral.repository")
+
+// treeLockKey identifies the transaction-level advisory lock guarding the referral tree.
+const treeLockKey = 7_001_014
+
+// Repository implements the contract.ReferralRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Referral, model.ReferralFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new referral repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Referral, model.ReferralFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.ReferralRepository = (*Repository)(nil)
+
+// FindByRefereeID retrieves the active referral of a referred user.
+func (r *Repository) FindByRefereeID(ctx context.Context, refereeID uint) (*model.Referral, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByRefereeID")
+	defer span.End()
+
+	var data model.Referral
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND referee_id = ?", refereeID).
+		First(&data).Error
+	return &data, err
+}
+
+// FindByRefereeIDForUpdate retrieves the active referral of a referred user and locks the row until the transaction ends.
+// It must be called within a transaction.
+func (r *Repository) FindByRefereeIDForUpdate(ctx context.Context, refereeID uint) (*model.Referral, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByRefereeIDForUpdate")
+	defer span.End()
+
+	var data model.Referral
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_on IS NULL AND referee_id = ?", refereeID).
+		First(&data).Error
+	return &data, err
+}
+
+// LockTree takes a transaction-level advisory lock, so new referrals are added one at a time
+// and concurrent applications cannot close a cycle between them. It must be called within a transaction.
+func (r *Repository) LockTree(ctx context.Context) error {
+	ctx, span := repositoryTracer.Start(ctx, "LockTree")
+	defer span.End()
+
+	return r.db.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(?)", treeLockKey).Error
+}
+
+// FindTree walks down the referrals below a referrer with a recursive query, stopping at maxDepth.
+// Rows are ordered by depth, so every referrer comes before the users they referred.
+func (r *Repository) FindTree(ctx context.Context, referrerID uint, maxDepth int) ([]model.ReferralTreeRow, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindTree")
+	defer span.End()
+
+	var data []model.ReferralTreeRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE tree AS (
+			SELECT r.*, 1 AS depth
+			FROM "user"."referrals" r
+			WHERE r.deleted_on IS NULL AND r.referrer_id = ?
+			UNION ALL
+			SELECT r.*, t.depth + 1
+			FROM "user"."referrals" r
+			JOIN tree t ON r.referrer_id = t.referee_id
+			WHERE r.deleted_on IS NULL AND t.depth < ?
+		)
+		SELECT tree.*, u.full_name AS referee_name
+		FROM tree
+		JOIN "user"."users" u ON u.id = tree.referee_id
+		ORDER BY tree.depth ASC, tree.id ASC`, referrerID, maxDepth).
+		Scan(&data).Error
+	return data, err
+}
@@ -0,0 +1,19 @@
+package referral
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers referral-related routes to the provided router group.
+// Listing the referrals of all users requires the referral:list permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	users := router.Group("/users/:id")
+	users.Get("/referral-code", handler.GetUserReferralCode)
+	users.Put("/referral-code", handler.UpdateUserReferralCode)
+	users.Post("/referrer", handler.ApplyReferralCode)
+	users.Get("/referrals/tree", handler.GetUserReferralTree)
+
+	router.Get("/referrals", authz.Require(rbac.ReferralList), handler.GetAllReferrals)
+}
@@ -0,0 +1,292 @@
+package referral
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/user"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("referral.service")
+
+const (
+	// codeConstraint is the unique index on active referral codes.
+	codeConstraint = "ux_users_referral_code_active"
+
+	// maxCodeAttempts is the number of times code assignment is attempted when the generated code collides.
+	maxCodeAttempts = 5
+
+	// defaultTreeDepth is the number of levels returned by the referral tree when no depth is requested.
+	defaultTreeDepth = 3
+)
+
+type service struct {
+	uow           contract.UnitOfWork
+	mapper        contract.Mapper
+	codeGenerator contract.ReferralCodeGenerator
+}
+
+// NewService initializes a new instance of referral service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, codeGenerator contract.ReferralCodeGenerator) *service {
+	return &service{uow: uow, mapper: mapper, codeGenerator: codeGenerator}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.ReferralService = (*service)(nil)
+
+// GetUserReferralCode retrieves the referral code of a user. Users without a code get a generated one,
+// retrying with a fresh code when it collides with an existing one.
+func (s *service) GetUserReferralCode(ctx context.Context, userID uint) (*payload.ReferralCodeResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetUserReferralCode")
+	defer span.End()
+
+	u, err := s.uow.UserRepository().FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, user.ErrUserNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	if u.ReferralCode != nil {
+		return &payload.ReferralCodeResponse{UserID: u.ID, Code: *u.ReferralCode}, nil
+	}
+
+	// The failed statement aborts the transaction, so the whole transaction is retried with a fresh code.
+	var code string
+	for attempt := 1; attempt <= maxCodeAttempts; attempt++ {
+		code, err = s.assignCode(ctx, userID)
+		if !isCodeConflict(err) {
+			break
+		}
+	}
+	if err != nil {
+		if isCodeConflict(err) {
+			return nil, ErrReferralCodeUnavailable(err)
+		}
+
+		return nil, err
+	}
+
+	return &payload.ReferralCodeResponse{UserID: userID, Code: code}, nil
+}
+
+// UpdateUserReferralCode replaces the referral code of a user with a code of their choice.
+// Referrals already made keep the code that was applied at the time.
+func (s *service) UpdateUserReferralCode(ctx context.Context, userID uint, req payload.ReferralCodeUpdateRequest) (*payload.ReferralCodeResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "UpdateUserReferralCode")
+	defer span.End()
+
+	code := NormalizeCode(req.Code)
+
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		u, err := uow.UserRepository().FindByIDForUpdate(ctx, userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return user.ErrUserNotFound(err)
+			}
+
+			return err
+		}
+
+		return s.setCode(ctx, uow, u, code)
+	})
+	if err != nil {
+		if isCodeConflict(err) {
+			return nil, ErrReferralCodeTaken(err)
+		}
+
+		return nil, err
+	}
+
+	return &payload.ReferralCodeResponse{UserID: userID, Code: code}, nil
+}
+
+// ApplyReferralCode records that a user was referred by the owner of the given code.
+func (s *service) ApplyReferralCode(ctx context.Context, userID uint, req payload.ReferralApplyRequest) (*payload.ReferralResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ApplyReferralCode")
+	defer span.End()
+
+	var created *model.Referral
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		created, err = Apply(ctx, uow, userID, req.Code)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.ReferralResponse
+	err = s.mapper.ToResponse(created, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAllReferrals retrieves a list of referrals with support for pagination and filtering.
+// Filtering on the rewarded status lists the reward payouts.
+func (s *service) GetAllReferrals(ctx context.Context, req payload.ReferralGetAllRequest) ([]payload.ReferralResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllReferrals")
+	defer span.End()
+
+	if req.ReferralFilter == nil {
+		req.ReferralFilter = &model.ReferralFilter{}
+	}
+
+	var count int64
+	var referrals []model.Referral
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.ReferralRepository().Count(groupCtx, req.ReferralFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		referrals, err = s.uow.ReferralRepository().FindAll(groupCtx, req.Page, req.Size, req.ReferralFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := []payload.ReferralResponse{}
+	err = s.mapper.ToResponse(referrals, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetUserReferralTree retrieves the users referred by a user, nested under whoever referred them,
+// down to the requested depth.
+func (s *service) GetUserReferralTree(ctx context.Context, userID uint, req payload.ReferralTreeRequest) (*payload.ReferralTreeResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetUserReferralTree")
+	defer span.End()
+
+	depth := defaultTreeDepth
+	if req.Depth != nil {
+		depth = *req.Depth
+	}
+
+	u, err := s.uow.UserRepository().FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, user.ErrUserNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	rows, err := s.uow.ReferralRepository().FindTree(ctx, userID, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &payload.ReferralTreeResponse{
+		UserID:         u.ID,
+		FullName:       u.FullName,
+		ReferralCode:   u.ReferralCode,
+		Depth:          depth,
+		TotalReferrals: len(rows),
+		Referrals:      []*payload.ReferralTreeNode{},
+	}
+
+	// Rows come ordered by depth, so a node's referrer is always added before the node itself
+	total := decimal.Zero
+	nodes := make(map[uint]*payload.ReferralTreeNode, len(rows))
+	for _, row := range rows {
+		node := &payload.ReferralTreeNode{
+			ReferralID: row.ID,
+			UserID:     row.RefereeID,
+			FullName:   row.RefereeName,
+			Depth:      row.Depth,
+			Status:     string(row.Status),
+			RewardedOn: row.RewardedOn,
+			Referrals:  []*payload.ReferralTreeNode{},
+		}
+		if row.CreatedOn != nil {
+			node.CreatedOn = *row.CreatedOn
+		}
+		if row.RewardAmount != nil {
+			amount := row.RewardAmount.StringFixed(2)
+			node.RewardAmount = &amount
+			total = total.Add(*row.RewardAmount)
+		}
+
+		nodes[row.RefereeID] = node
+		if parent, ok := nodes[row.ReferrerID]; ok && row.Depth > 1 {
+			parent.Referrals = append(parent.Referrals, node)
+		} else {
+			resp.Referrals = append(resp.Referrals, node)
+		}
+	}
+	resp.TotalRewardedAmount = total.StringFixed(2)
+
+	return resp, nil
+}
+
+// assignCode generates and saves a referral code for a user that has none, in its own transaction.
+// A concurrent request may have assigned one first, in which case that code is returned.
+func (s *service) assignCode(ctx context.Context, userID uint) (string, error) {
+	var code string
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		u, err := uow.UserRepository().FindByIDForUpdate(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if u.ReferralCode != nil {
+			code = *u.ReferralCode
+			return nil
+		}
+
+		code, err = s.codeGenerator.Generate()
+		if err != nil {
+			return err
+		}
+
+		return s.setCode(ctx, uow, u, code)
+	})
+	return code, err
+}
+
+// setCode saves a new referral code on a locked user.
+func (s *service) setCode(ctx context.Context, uow contract.UnitOfWork, u *model.User, code string) error {
+	now := time.Now()
+	u.ReferralCode = &code
+	u.ModifiedOn = &now
+	u.ModifiedBy = actor.FromContext(ctx).JSON()
+
+	_, err := uow.UserRepository().Update(ctx, u)
+	return err
+}
+
+// isCodeConflict reports whether err is a unique violation on the referral code index.
+func isCodeConflict(err error) bool {
+	pgErr := dberror.GetError(err)
+	return pgErr != nil && pgErr.Code == dberror.UniqueViolation && pgErr.ConstraintName == codeConstraint
+}
@@ -0,0 +1,70 @@
+package refund
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Refund Error Constructors
+// ==========================================================
+
+// ErrRefundNotFound creates a new error for missing refund records.
+func ErrRefundNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"refund not found",
+		err,
+		nil,
+	)
+}
+
+// ErrInvalidAmount creates a new error for refund amounts that are not a positive number.
+func ErrInvalidAmount(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		err,
+		map[string]any{"amount": apperror.InvalidValue},
+	)
+}
+
+// ErrAmountExceedsPayment creates a new error for refund amounts exceeding the total payment of a booking.
+func ErrAmountExceedsPayment(totalPayment string) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"refund amount exceeds the total payment of "+totalPayment,
+		nil,
+		map[string]any{"amount": apperror.ValueTooHigh},
+	)
+}
+
+// ErrBookingNotRefundable creates a new error for refund requests on a booking that cannot be refunded.
+func ErrBookingNotRefundable(status model.BookingStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking cannot be refunded in its current status",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrRefundExists creates a new error for requesting a refund on a booking that already has one in progress.
+func ErrRefundExists(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking already has a refund in progress",
+		err,
+		nil,
+	)
+}
+
+// ErrInvalidTransition creates a new error for refund status changes that the workflow does not allow.
+func ErrInvalidTransition(from, to model.RefundStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"refund cannot be "+string(to)+" in its current status",
+		nil,
+		map[string]any{"from": from, "to": to},
+	)
+}
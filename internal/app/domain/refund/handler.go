@@ -0,0 +1,233 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("refund.handler")
+
+type Handler struct {
+	service contract.RefundService
+}
+
+// NewHandler initializes a new instance of RefundHandler.
+func NewHandler(service contract.RefundService) *Handler {
+	return &Handler{service: service}
+}
+
+// RequestRefund handles a refund request on a booking.
+func (h *Handler) RequestRefund(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "RequestRefund")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.RefundCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	refund, err := h.service.RequestRefund(ctx, uint(bookingID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(refund, nil))
+}
+
+// GetAllRefunds retrieves all refunds with pagination and filtering.
+func (h *Handler) GetAllRefunds(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAllRefunds")
+	defer span.End()
+
+	req := payload.RefundGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	refunds, pagination, err := h.service.GetAllRefunds(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(refunds, pagination))
+}
+
+// GetBookingRefunds retrieves the refunds of a booking with pagination.
+func (h *Handler) GetBookingRefunds(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookingRefunds")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	req := payload.RefundGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	refunds, pagination, err := h.service.GetBookingRefunds(ctx, uint(bookingID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(refunds, pagination))
+}
+
+// GetRefund retrieves a single refund by its ID.
+func (h *Handler) GetRefund(c *fiber.Ctx) error {
+	return h.act(c, "GetRefund", func(ctx context.Context, id uint) (*payload.RefundBaseResponse, error) {
+		return h.service.GetRefundByID(ctx, id)
+	})
+}
+
+// ApproveRefund handles the approval of a requested refund.
+func (h *Handler) ApproveRefund(c *fiber.Ctx) error {
+	return h.act(c, "ApproveRefund", h.service.ApproveRefund)
+}
+
+// RejectRefund handles the rejection of a requested refund. A reason is required.
+func (h *Handler) RejectRefund(c *fiber.Ctx) error {
+	var req payload.RefundRejectRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	return h.act(c, "RejectRefund", func(ctx context.Context, id uint) (*payload.RefundBaseResponse, error) {
+		return h.service.RejectRefund(ctx, id, req)
+	})
+}
+
+// ProcessRefund handles marking an approved refund as processed.
+func (h *Handler) ProcessRefund(c *fiber.Ctx) error {
+	// The body is optional; it only carries the transfer reference
+	var req payload.RefundProcessRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+		}
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	return h.act(c, "ProcessRefund", func(ctx context.Context, id uint) (*payload.RefundBaseResponse, error) {
+		return h.service.ProcessRefund(ctx, id, req)
+	})
+}
+
+// CompleteRefund handles the completion of a processed refund.
+func (h *Handler) CompleteRefund(c *fiber.Ctx) error {
+	return h.act(c, "CompleteRefund", h.service.CompleteRefund)
+}
+
+// actionFunc is the shape of the service methods that operate on a single refund.
+type actionFunc func(ctx context.Context, id uint) (*payload.RefundBaseResponse, error)
+
+// act parses the refund ID from the path, invokes the action and writes the response.
+func (h *Handler) act(c *fiber.Ctx, name string, fn actionFunc) error {
+	ctx, span := handlerTracer.Start(c.Context(), name)
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	refund, err := fn(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(refund, nil))
+}
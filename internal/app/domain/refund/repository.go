@@ -0,0 +1,44 @@
+package refund
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("refund.repository")
+
+// Repository implements the contract.RefundRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Refund, model.RefundFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new refund repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Refund, model.RefundFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.RefundRepository = (*Repository)(nil)
+
+// FindOpenByBookingID retrieves the refund of a booking that has not been rejected, if any.
+func (r *Repository) FindOpenByBookingID(ctx context.Context, bookingID uint) (*model.Refund, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindOpenByBookingID")
+	defer span.End()
+
+	var data model.Refund
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND booking_id = ? AND status <> ?", bookingID, model.RefundStatusRejected).
+		First(&data).Error
+	return &data, err
+}
@@ -0,0 +1,22 @@
+package refund
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers refund-related routes to the provided router group.
+// Listing all refunds and moving them through review requires the refund:review permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	router.Post("/bookings/:id/refunds", handler.RequestRefund)
+	router.Get("/bookings/:id/refunds", handler.GetBookingRefunds)
+
+	refunds := router.Group("/refunds")
+	refunds.Get("/", authz.Require(rbac.RefundReview), handler.GetAllRefunds)
+	refunds.Get("/:id", handler.GetRefund)
+	refunds.Post("/:id/approve", authz.Require(rbac.RefundReview), handler.ApproveRefund)
+	refunds.Post("/:id/reject", authz.Require(rbac.RefundReview), handler.RejectRefund)
+	refunds.Post("/:id/process", authz.Require(rbac.RefundReview), handler.ProcessRefund)
+	refunds.Post("/:id/complete", authz.Require(rbac.RefundReview), handler.CompleteRefund)
+}
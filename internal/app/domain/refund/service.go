@@ -0,0 +1,386 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/domain/wallet"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("refund.service")
+
+// openRefundConstraint is the partial unique index allowing a single non-rejected refund per booking.
+const openRefundConstraint = "ux_refunds_booking_id_open"
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+}
+
+// NewService initializes a new instance of refund service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper) *service {
+	return &service{uow: uow, mapper: mapper}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.RefundService = (*service)(nil)
+
+// RequestRefund submits a refund request on a booking.
+// The booking row is locked while the request is validated so the refund amount is checked
+// against a consistent total payment; a booking can only have one refund in progress at a time.
+// Customers can only request refunds on their own bookings.
+func (s *service) RequestRefund(ctx context.Context, bookingID uint, req payload.RefundCreateRequest) (*payload.RefundBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RequestRefund")
+	defer span.End()
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, ErrInvalidAmount(err)
+	}
+	if !amount.IsPositive() {
+		return nil, ErrInvalidAmount(nil)
+	}
+
+	var created *model.Refund
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		err = booking.Authorize(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		if !booking.CanTransition(b.Status, model.BookingStatusRefunded) || !b.TotalPayment.IsPositive() {
+			return ErrBookingNotRefundable(b.Status)
+		}
+
+		if amount.GreaterThan(b.TotalPayment) {
+			return ErrAmountExceedsPayment(b.TotalPayment.StringFixed(2))
+		}
+
+		_, err = uow.RefundRepository().FindOpenByBookingID(ctx, b.ID)
+		if err == nil {
+			return ErrRefundExists(nil)
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		created, err = uow.RefundRepository().Save(ctx, &model.Refund{
+			BookingID: b.ID,
+			Amount:    amount,
+			Status:    model.RefundStatusRequested,
+			Reason:    req.Reason,
+			CreatedBy: actor.FromContext(ctx).JSON(),
+		})
+		return err
+	})
+	if err != nil {
+		if isOpenRefundConflict(err) {
+			return nil, ErrRefundExists(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toResponse(created)
+}
+
+// GetAllRefunds retrieves a paginated list of refunds, typically for the admin and finance review queues.
+func (s *service) GetAllRefunds(ctx context.Context, req payload.RefundGetAllRequest) ([]payload.RefundBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllRefunds")
+	defer span.End()
+
+	if req.RefundFilter == nil {
+		req.RefundFilter = &model.RefundFilter{}
+	}
+
+	return s.findAll(ctx, req)
+}
+
+// GetBookingRefunds retrieves the refunds of a booking with support for pagination.
+// Only staff can view the refunds of any booking.
+func (s *service) GetBookingRefunds(ctx context.Context, bookingID uint, req payload.RefundGetAllRequest) ([]payload.RefundBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingRefunds")
+	defer span.End()
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, nil, err
+	}
+
+	err = booking.Authorize(ctx, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.RefundFilter == nil {
+		req.RefundFilter = &model.RefundFilter{}
+	}
+	req.BookingID = &bookingID
+
+	return s.findAll(ctx, req)
+}
+
+// GetRefundByID retrieves a specific refund by its unique identifier.
+// Only staff can view any refund; others only the refunds of their own bookings.
+func (s *service) GetRefundByID(ctx context.Context, id uint) (*payload.RefundBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetRefundByID")
+	defer span.End()
+
+	refund, err := s.uow.RefundRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRefundNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, refund.BookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = booking.Authorize(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(refund)
+}
+
+// ApproveRefund approves a requested refund.
+func (s *service) ApproveRefund(ctx context.Context, id uint) (*payload.RefundBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ApproveRefund")
+	defer span.End()
+
+	return s.advance(ctx, id, model.RefundStatusApproved, func(refund *model.Refund, now time.Time, by datatypes.JSON) {
+		refund.ReviewedBy = by
+		refund.ReviewedOn = &now
+	})
+}
+
+// RejectRefund rejects a requested refund. The booking may request a new refund afterwards.
+func (s *service) RejectRefund(ctx context.Context, id uint, req payload.RefundRejectRequest) (*payload.RefundBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RejectRefund")
+	defer span.End()
+
+	return s.advance(ctx, id, model.RefundStatusRejected, func(refund *model.Refund, now time.Time, by datatypes.JSON) {
+		refund.RejectionReason = &req.Reason
+		refund.ReviewedBy = by
+		refund.ReviewedOn = &now
+	})
+}
+
+// ProcessRefund marks an approved refund as processed once finance has issued the transfer.
+// The destination, if given, decides whether completion pays out to the bank or to the customer's wallet.
+func (s *service) ProcessRefund(ctx context.Context, id uint, req payload.RefundProcessRequest) (*payload.RefundBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ProcessRefund")
+	defer span.End()
+
+	return s.advance(ctx, id, model.RefundStatusProcessed, func(refund *model.Refund, now time.Time, by datatypes.JSON) {
+		if req.Reference != nil {
+			refund.Reference = req.Reference
+		}
+		if req.Destination != nil {
+			refund.Destination = model.RefundDestination(*req.Destination)
+		}
+		refund.ProcessedBy = by
+		refund.ProcessedOn = &now
+	})
+}
+
+// CompleteRefund completes a processed refund and moves its booking to refunded in the same transaction.
+// The booking is locked before the refund, matching the lock order of RequestRefund, and the amount is
+// checked once more against the booking's total payment. Refunds sent to the wallet are credited to
+// the booker's wallet in the same transaction.
+func (s *service) CompleteRefund(ctx context.Context, id uint) (*payload.RefundBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CompleteRefund")
+	defer span.End()
+
+	var updated *model.Refund
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		refund, err := uow.RefundRepository().FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrRefundNotFound(err)
+			}
+
+			return err
+		}
+
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, refund.BookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		refund, err = uow.RefundRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !CanTransition(refund.Status, model.RefundStatusCompleted) {
+			return ErrInvalidTransition(refund.Status, model.RefundStatusCompleted)
+		}
+
+		if refund.Amount.GreaterThan(b.TotalPayment) {
+			return ErrAmountExceedsPayment(b.TotalPayment.StringFixed(2))
+		}
+
+		now := time.Now()
+		by := actor.FromContext(ctx).JSON()
+
+		refund.Status = model.RefundStatusCompleted
+		refund.CompletedBy = by
+		refund.CompletedOn = &now
+		refund.ModifiedOn = &now
+		refund.ModifiedBy = by
+
+		updated, err = uow.RefundRepository().Update(ctx, refund)
+		if err != nil {
+			return err
+		}
+
+		if refund.Destination == model.RefundDestinationWallet {
+			_, err = wallet.Credit(ctx, uow, b.UserID, wallet.Posting{
+				Type:      model.WalletTransactionRefund,
+				Amount:    refund.Amount,
+				BookingID: &b.ID,
+				RefundID:  &refund.ID,
+				Reference: &b.Code,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		reason := "refund " + refund.UID + " completed"
+		return booking.Transition(ctx, uow, b, model.BookingStatusRefunded, &reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(updated)
+}
+
+// advance moves a refund to the target status within a transaction, applying 'apply' to
+// record who performed the step and when.
+func (s *service) advance(ctx context.Context, id uint, to model.RefundStatus, apply func(*model.Refund, time.Time, datatypes.JSON)) (*payload.RefundBaseResponse, error) {
+	var updated *model.Refund
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		refund, err := uow.RefundRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrRefundNotFound(err)
+			}
+
+			return err
+		}
+
+		if !CanTransition(refund.Status, to) {
+			return ErrInvalidTransition(refund.Status, to)
+		}
+
+		now := time.Now()
+		by := actor.FromContext(ctx).JSON()
+
+		refund.Status = to
+		refund.ModifiedOn = &now
+		refund.ModifiedBy = by
+		apply(refund, now, by)
+
+		updated, err = uow.RefundRepository().Update(ctx, refund)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(updated)
+}
+
+// findAll fetches a page of refunds and the total count concurrently.
+func (s *service) findAll(ctx context.Context, req payload.RefundGetAllRequest) ([]payload.RefundBaseResponse, *response.Pagination, error) {
+	var count int64
+	var refunds []model.Refund
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.RefundRepository().Count(groupCtx, req.RefundFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		refunds, err = s.uow.RefundRepository().FindAll(groupCtx, req.Page, req.Size, req.RefundFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp []payload.RefundBaseResponse
+	err = s.mapper.ToResponse(refunds, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// toResponse maps a refund to the response DTO.
+func (s *service) toResponse(refund *model.Refund) (*payload.RefundBaseResponse, error) {
+	var resp payload.RefundBaseResponse
+	err := s.mapper.ToResponse(refund, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// isOpenRefundConflict reports whether err is a unique violation on the open refund index.
+func isOpenRefundConflict(err error) bool {
+	pgErr := dberror.GetError(err)
+	return pgErr != nil && pgErr.Code == dberror.UniqueViolation && pgErr.ConstraintName == openRefundConstraint
+}
@@ -0,0 +1,28 @@
+package refund
+
+import (
+	"slices"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// transitions defines the refund workflow as a state machine.
+// Admins approve or reject a request; finance then marks an approved refund as processed
+// once the transfer is issued and completed once it has been received.
+var transitions = map[model.RefundStatus][]model.RefundStatus{
+	model.RefundStatusRequested: {
+		model.RefundStatusApproved,
+		model.RefundStatusRejected,
+	},
+	model.RefundStatusApproved: {
+		model.RefundStatusProcessed,
+	},
+	model.RefundStatusProcessed: {
+		model.RefundStatusCompleted,
+	},
+}
+
+// CanTransition reports whether a refund may move from one status to another.
+func CanTransition(from, to model.RefundStatus) bool {
+	return slices.Contains(transitions[from], to)
+}
@@ -0,0 +1,90 @@
+package reschedule
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Reschedule Error Constructors
+// ==========================================================
+
+// ErrRescheduleNotFound creates a new error for missing reschedule records.
+func ErrRescheduleNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"reschedule not found",
+		err,
+		nil,
+	)
+}
+
+// ErrBookingNotReschedulable creates a new error for reschedule requests on a booking that can no longer be moved.
+func ErrBookingNotReschedulable(status model.BookingStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking cannot be rescheduled in its current status",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrInstallmentPriceChange creates a new error for reschedules that would change the price of a booking paid in installments.
+func ErrInstallmentPriceChange() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking with an installment plan can only be rescheduled at the same price",
+		nil,
+		nil,
+	)
+}
+
+// ErrProductNotAvailable creates a new error for reschedules targeting an inactive product.
+func ErrProductNotAvailable() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"target product is not available for booking",
+		nil,
+		map[string]any{"product_id": apperror.InvalidValue},
+	)
+}
+
+// ErrInvalidDate creates a new error for target travel dates that are not in the future.
+func ErrInvalidDate() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		nil,
+		map[string]any{"date": apperror.InvalidValue},
+	)
+}
+
+// ErrRescheduleExists creates a new error for requesting a reschedule on a booking that already has one pending.
+func ErrRescheduleExists(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking already has a pending reschedule",
+		err,
+		nil,
+	)
+}
+
+// ErrInvalidTransition creates a new error for reschedule status changes that the workflow does not allow.
+func ErrInvalidTransition(from, to model.RescheduleStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"reschedule cannot be "+string(to)+" in its current status",
+		nil,
+		map[string]any{"from": from, "to": to},
+	)
+}
+
+// ErrBookingChanged creates a new error for processing a reschedule whose booking was modified after the request.
+func ErrBookingChanged() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"booking has changed since the reschedule was requested; please request it again",
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,239 @@
+package reschedule
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var handlerTracer trace.Tracer = otel.Tracer("reschedule.handler")
+
+type Handler struct {
+	service contract.RescheduleService
+}
+
+// NewHandler initializes a new instance of RescheduleHandler.
+func NewHandler(service contract.RescheduleService) *Handler {
+	return &Handler{service: service}
+}
+
+// RequestReschedule handles a reschedule request on a booking.
+func (h *Handler) RequestReschedule(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "RequestReschedule")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	var req payload.RescheduleCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	reschedule, err := h.service.RequestReschedule(ctx, uint(bookingID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.Success(reschedule, nil))
+}
+
+// GetAllReschedules retrieves all reschedules with pagination and filtering.
+func (h *Handler) GetAllReschedules(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetAllReschedules")
+	defer span.End()
+
+	req := payload.RescheduleGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	reschedules, pagination, err := h.service.GetAllReschedules(ctx, req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(reschedules, pagination))
+}
+
+// GetBookingReschedules retrieves the reschedules of a booking with pagination.
+func (h *Handler) GetBookingReschedules(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "GetBookingReschedules")
+	defer span.End()
+
+	bookingID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	req := payload.RescheduleGetAllRequest{}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.QueryParserError(err))
+	}
+
+	if req.CommonGetAllRequest == nil {
+		req.CommonGetAllRequest = &payload.CommonGetAllRequest{}
+	}
+	req.SetDefault()
+
+	reschedules, pagination, err := h.service.GetBookingReschedules(ctx, uint(bookingID), req)
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(reschedules, pagination))
+}
+
+// GetReschedule retrieves a single reschedule by its ID.
+func (h *Handler) GetReschedule(c *fiber.Ctx) error {
+	return h.act(c, "GetReschedule", func(ctx context.Context, id uint) (*payload.RescheduleBaseResponse, error) {
+		return h.service.GetRescheduleByID(ctx, id)
+	})
+}
+
+// ApproveReschedule handles the approval of a requested reschedule.
+func (h *Handler) ApproveReschedule(c *fiber.Ctx) error {
+	return h.act(c, "ApproveReschedule", h.service.ApproveReschedule)
+}
+
+// RejectReschedule handles the rejection of a requested reschedule. A reason is required.
+func (h *Handler) RejectReschedule(c *fiber.Ctx) error {
+	var req payload.RescheduleRejectRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.JSONParserError(err))
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(response.ValidationError(err))
+	}
+
+	return h.act(c, "RejectReschedule", func(ctx context.Context, id uint) (*payload.RescheduleBaseResponse, error) {
+		return h.service.RejectReschedule(ctx, id, req)
+	})
+}
+
+// ProcessReschedule handles applying an approved reschedule to its booking.
+func (h *Handler) ProcessReschedule(c *fiber.Ctx) error {
+	ctx, span := handlerTracer.Start(c.Context(), "ProcessReschedule")
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	result, err := h.service.ProcessReschedule(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(result, nil))
+}
+
+// actionFunc is the shape of the service methods that operate on a single reschedule.
+type actionFunc func(ctx context.Context, id uint) (*payload.RescheduleBaseResponse, error)
+
+// act parses the reschedule ID from the path, invokes the action and writes the response.
+func (h *Handler) act(c *fiber.Ctx, name string, fn actionFunc) error {
+	ctx, span := handlerTracer.Start(c.Context(), name)
+	defer span.End()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(
+			response.Error(apperror.Validation, "invalid id", nil),
+		)
+	}
+
+	reschedule, err := fn(ctx, uint(id))
+	if err != nil {
+		c.Locals("error", err)
+
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+				response.Error(appErr.Code, appErr.Message, appErr.Details),
+			)
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(
+			response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+		)
+	}
+
+	return c.JSON(response.Success(reschedule, nil))
+}
@@ -0,0 +1,45 @@
+package reschedule
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("reschedule.repository")
+
+// Repository implements the contract.RescheduleRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Reschedule, model.RescheduleFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new reschedule repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Reschedule, model.RescheduleFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.RescheduleRepository = (*Repository)(nil)
+
+// FindOpenByBookingID retrieves the reschedule of a booking that is still requested or approved, if any.
+func (r *Repository) FindOpenByBookingID(ctx context.Context, bookingID uint) (*model.Reschedule, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindOpenByBookingID")
+	defer span.End()
+
+	var data model.Reschedule
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND booking_id = ? AND status IN ?", bookingID,
+			[]model.RescheduleStatus{model.RescheduleStatusRequested, model.RescheduleStatusApproved}).
+		First(&data).Error
+	return &data, err
+}
@@ -0,0 +1,21 @@
+package reschedule
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers reschedule-related routes to the provided router group.
+// Listing all reschedules and moving them through review requires the reschedule:review permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	router.Post("/bookings/:id/reschedules", handler.RequestReschedule)
+	router.Get("/bookings/:id/reschedules", handler.GetBookingReschedules)
+
+	reschedules := router.Group("/reschedules")
+	reschedules.Get("/", authz.Require(rbac.RescheduleReview), handler.GetAllReschedules)
+	reschedules.Get("/:id", handler.GetReschedule)
+	reschedules.Post("/:id/approve", authz.Require(rbac.RescheduleReview), handler.ApproveReschedule)
+	reschedules.Post("/:id/reject", authz.Require(rbac.RescheduleReview), handler.RejectReschedule)
+	reschedules.Post("/:id/process", authz.Require(rbac.RescheduleReview), handler.ProcessReschedule)
+}
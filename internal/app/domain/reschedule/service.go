@@ -0,0 +1,488 @@
+package reschedule
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/domain/departure"
+	"github.com/aburizalpurnama/travel/internal/app/domain/passenger"
+	"github.com/aburizalpurnama/travel/internal/app/domain/payment"
+	"github.com/aburizalpurnama/travel/internal/app/domain/product"
+	"github.com/aburizalpurnama/travel/internal/app/domain/wallet"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("reschedule.service")
+
+// openRescheduleConstraint is the partial unique index allowing a single pending reschedule per booking.
+const openRescheduleConstraint = "ux_reschedules_booking_id_open"
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+	rule   payment.DownPaymentRule
+}
+
+// NewService initializes a new instance of reschedule service.
+// The down-payment rule is used to derive the booking's payment status after its amount changes.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper, rule payment.DownPaymentRule) *service {
+	return &service{uow: uow, mapper: mapper, rule: rule}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.RescheduleService = (*service)(nil)
+
+// RequestReschedule submits a request to move a booking to another product and travel date.
+// The booking and the target are snapshotted and the price difference is quoted from the
+// current price of the target product for the booking's quantity.
+// Customers can only reschedule their own bookings.
+func (s *service) RequestReschedule(ctx context.Context, bookingID uint, req payload.RescheduleCreateRequest) (*payload.RescheduleBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RequestReschedule")
+	defer span.End()
+
+	if req.DepartureBatchID == nil && !req.Date.After(time.Now()) {
+		return nil, ErrInvalidDate()
+	}
+
+	var created *model.Reschedule
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		err = booking.Authorize(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		if !reschedulableStatuses[b.Status] {
+			return ErrBookingNotReschedulable(b.Status)
+		}
+
+		p, err := uow.ProductRepository().FindByID(ctx, req.ProductID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return product.ErrProductNotFound(err)
+			}
+
+			return err
+		}
+
+		if p.IsActive != nil && !*p.IsActive {
+			return ErrProductNotAvailable()
+		}
+
+		var toDate time.Time
+		if req.DepartureBatchID != nil {
+			batch, err := departure.CheckAvailability(ctx, uow, *req.DepartureBatchID, p.ID, b.TotalQty)
+			if err != nil {
+				return err
+			}
+			toDate = batch.DepartureDate
+		} else {
+			toDate = *req.Date
+		}
+
+		// The booking keeps its voucher discount, capped at the new subtotal
+		toTotal := p.Price.Mul(decimal.NewFromInt(int64(b.TotalQty))).Sub(b.DiscountAmount)
+		if toTotal.IsNegative() {
+			toTotal = decimal.Zero
+		}
+		difference := toTotal.Sub(b.TotalAmount)
+		if b.InstallmentRequestStatus != nil && !difference.IsZero() {
+			return ErrInstallmentPriceChange()
+		}
+
+		_, err = uow.RescheduleRepository().FindOpenByBookingID(ctx, b.ID)
+		if err == nil {
+			return ErrRescheduleExists(nil)
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		var fromDate time.Time
+		if b.Date != nil {
+			fromDate = *b.Date
+		}
+
+		created, err = uow.RescheduleRepository().Save(ctx, &model.Reschedule{
+			BookingID:       b.ID,
+			Status:          model.RescheduleStatusRequested,
+			FromProductID:   b.ProductID,
+			FromProductName: b.ProductName,
+			FromDate:        fromDate,
+			FromTotalAmount: b.TotalAmount,
+			ToProductID:     p.ID,
+			ToProductName:   p.Name,
+			ToDate:          toDate,
+			ToTotalAmount:   toTotal,
+			PriceDifference: difference,
+			Reason:          req.Reason,
+			CreatedBy:       actor.FromContext(ctx).JSON(),
+
+			FromDepartureBatchID: b.DepartureBatchID,
+			ToDepartureBatchID:   req.DepartureBatchID,
+		})
+		return err
+	})
+	if err != nil {
+		if isOpenRescheduleConflict(err) {
+			return nil, ErrRescheduleExists(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toResponse(created)
+}
+
+// GetAllReschedules retrieves a paginated list of reschedules, typically for the admin review queue.
+func (s *service) GetAllReschedules(ctx context.Context, req payload.RescheduleGetAllRequest) ([]payload.RescheduleBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllReschedules")
+	defer span.End()
+
+	if req.RescheduleFilter == nil {
+		req.RescheduleFilter = &model.RescheduleFilter{}
+	}
+
+	return s.findAll(ctx, req)
+}
+
+// GetBookingReschedules retrieves the reschedules of a booking with support for pagination.
+// Only staff can view the reschedules of any booking.
+func (s *service) GetBookingReschedules(ctx context.Context, bookingID uint, req payload.RescheduleGetAllRequest) ([]payload.RescheduleBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetBookingReschedules")
+	defer span.End()
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, nil, err
+	}
+
+	err = booking.Authorize(ctx, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.RescheduleFilter == nil {
+		req.RescheduleFilter = &model.RescheduleFilter{}
+	}
+	req.BookingID = &bookingID
+
+	return s.findAll(ctx, req)
+}
+
+// GetRescheduleByID retrieves a specific reschedule by its unique identifier.
+// Only staff can view any reschedule; others only the reschedules of their own bookings.
+func (s *service) GetRescheduleByID(ctx context.Context, id uint) (*payload.RescheduleBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetRescheduleByID")
+	defer span.End()
+
+	reschedule, err := s.uow.RescheduleRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRescheduleNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	b, err := s.uow.BookingRepository().FindByID(ctx, reschedule.BookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, booking.ErrBookingNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = booking.Authorize(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(reschedule)
+}
+
+// ApproveReschedule approves a requested reschedule at its quoted price.
+func (s *service) ApproveReschedule(ctx context.Context, id uint) (*payload.RescheduleBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ApproveReschedule")
+	defer span.End()
+
+	return s.review(ctx, id, model.RescheduleStatusApproved, nil)
+}
+
+// RejectReschedule rejects a requested reschedule. The booking may request a new reschedule afterwards.
+func (s *service) RejectReschedule(ctx context.Context, id uint, req payload.RescheduleRejectRequest) (*payload.RescheduleBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RejectReschedule")
+	defer span.End()
+
+	return s.review(ctx, id, model.RescheduleStatusRejected, &req.Reason)
+}
+
+// ProcessReschedule applies an approved reschedule to its booking in a single transaction.
+// The booking's product, date and total amount are replaced with the quoted target, then its
+// total payment and payment status are recalculated so an extra charge leaves a balance to pay.
+// When the booking has been paid more than its new total, the surplus is credited to the customer's
+// wallet and the booking shows as paid in full. The booking must not have been
+// changed since the reschedule was requested. Seats move from the current departure batch to
+// the target one, failing when the target has sold out in the meantime, and the passenger
+// manifest must still meet the passport validity rule for the new departure date.
+func (s *service) ProcessReschedule(ctx context.Context, id uint) (*payload.RescheduleProcessResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ProcessReschedule")
+	defer span.End()
+
+	var updated *model.Reschedule
+	var updatedBooking *model.Booking
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		reschedule, err := uow.RescheduleRepository().FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrRescheduleNotFound(err)
+			}
+
+			return err
+		}
+
+		// Lock the booking before the reschedule, matching the lock order of RequestReschedule
+		b, err := uow.BookingRepository().FindByIDForUpdate(ctx, reschedule.BookingID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return booking.ErrBookingNotFound(err)
+			}
+
+			return err
+		}
+
+		reschedule, err = uow.RescheduleRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !CanTransition(reschedule.Status, model.RescheduleStatusProcessed) {
+			return ErrInvalidTransition(reschedule.Status, model.RescheduleStatusProcessed)
+		}
+
+		if !reschedulableStatuses[b.Status] {
+			return ErrBookingNotReschedulable(b.Status)
+		}
+
+		if !b.TotalAmount.Equal(reschedule.FromTotalAmount) {
+			return ErrBookingChanged()
+		}
+
+		if b.InstallmentRequestStatus != nil && !reschedule.PriceDifference.IsZero() {
+			return ErrInstallmentPriceChange()
+		}
+
+		// The passengers' passports must still be valid long enough after the new departure date
+		passengers, err := uow.BookingPassengerRepository().FindByBookingID(ctx, b.ID)
+		if err != nil {
+			return err
+		}
+
+		err = passenger.CheckPassports(passengers, reschedule.ToDate)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		by := actor.FromContext(ctx).JSON()
+
+		reschedule.Status = model.RescheduleStatusProcessed
+		reschedule.ProcessedBy = by
+		reschedule.ProcessedOn = &now
+		reschedule.ModifiedOn = &now
+		reschedule.ModifiedBy = by
+
+		updated, err = uow.RescheduleRepository().Update(ctx, reschedule)
+		if err != nil {
+			return err
+		}
+
+		// Move the seats from the current departure batch to the target one, if any
+		if b.DepartureBatchID != nil {
+			err = departure.Release(ctx, uow, *b.DepartureBatchID, b.TotalQty)
+			if err != nil {
+				return err
+			}
+		}
+		if reschedule.ToDepartureBatchID != nil {
+			_, err = departure.Reserve(ctx, uow, *reschedule.ToDepartureBatchID, reschedule.ToProductID, b.TotalQty)
+			if err != nil {
+				return err
+			}
+		}
+
+		b.DepartureBatchID = reschedule.ToDepartureBatchID
+		b.ProductID = &reschedule.ToProductID
+		b.ProductName = &reschedule.ToProductName
+		b.Date = &reschedule.ToDate
+		b.TotalAmount = reschedule.ToTotalAmount
+
+		surplus := b.TotalPayment.Sub(b.TotalAmount)
+		if surplus.IsPositive() {
+			err = creditSurplus(ctx, uow, b, surplus)
+			if err != nil {
+				return err
+			}
+		}
+
+		updatedBooking, err = payment.Reconcile(ctx, uow, b, s.rule)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.RescheduleProcessResponse
+	err = s.mapper.ToResponse(updated, &resp.Reschedule)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.mapper.ToResponse(updatedBooking, &resp.Booking)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// creditSurplus moves the amount a booking has been paid beyond its total to the customer's wallet.
+// The surplus leaves the booking as a negative reschedule credit payment, so the recalculated total
+// payment matches the total amount, and enters the wallet as a refund. It must be called within a transaction.
+func creditSurplus(ctx context.Context, uow contract.UnitOfWork, b *model.Booking, surplus decimal.Decimal) error {
+	notes := "Reschedule surplus credited to wallet"
+	credit, err := uow.PaymentRepository().Save(ctx, &model.Payment{
+		BookingID: b.ID,
+		Amount:    surplus.Neg(),
+		Method:    model.PaymentMethodRescheduleCredit,
+		Reference: &b.Code,
+		PaidAt:    time.Now(),
+		Notes:     &notes,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = wallet.Credit(ctx, uow, b.UserID, wallet.Posting{
+		Type:        model.WalletTransactionRefund,
+		Amount:      surplus,
+		BookingID:   &b.ID,
+		PaymentID:   &credit.ID,
+		Reference:   &b.Code,
+		Description: &notes,
+	})
+	return err
+}
+
+// review records an approval or rejection of a requested reschedule.
+func (s *service) review(ctx context.Context, id uint, to model.RescheduleStatus, rejectionReason *string) (*payload.RescheduleBaseResponse, error) {
+	var updated *model.Reschedule
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		reschedule, err := uow.RescheduleRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrRescheduleNotFound(err)
+			}
+
+			return err
+		}
+
+		if !CanTransition(reschedule.Status, to) {
+			return ErrInvalidTransition(reschedule.Status, to)
+		}
+
+		now := time.Now()
+		by := actor.FromContext(ctx).JSON()
+
+		reschedule.Status = to
+		reschedule.RejectionReason = rejectionReason
+		reschedule.ReviewedBy = by
+		reschedule.ReviewedOn = &now
+		reschedule.ModifiedOn = &now
+		reschedule.ModifiedBy = by
+
+		updated, err = uow.RescheduleRepository().Update(ctx, reschedule)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(updated)
+}
+
+// findAll fetches a page of reschedules and the total count concurrently.
+func (s *service) findAll(ctx context.Context, req payload.RescheduleGetAllRequest) ([]payload.RescheduleBaseResponse, *response.Pagination, error) {
+	var count int64
+	var reschedules []model.Reschedule
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.RescheduleRepository().Count(groupCtx, req.RescheduleFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		reschedules, err = s.uow.RescheduleRepository().FindAll(groupCtx, req.Page, req.Size, req.RescheduleFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp []payload.RescheduleBaseResponse
+	err = s.mapper.ToResponse(reschedules, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// toResponse maps a reschedule to the response DTO.
+func (s *service) toResponse(reschedule *model.Reschedule) (*payload.RescheduleBaseResponse, error) {
+	var resp payload.RescheduleBaseResponse
+	err := s.mapper.ToResponse(reschedule, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// isOpenRescheduleConflict reports whether err is a unique violation on the pending reschedule index.
+func isOpenRescheduleConflict(err error) bool {
+	pgErr := dberror.GetError(err)
+	return pgErr != nil && pgErr.Code == dberror.UniqueViolation && pgErr.ConstraintName == openRescheduleConstraint
+}
@@ -0,0 +1,30 @@
+package reschedule
+
+import (
+	"slices"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// transitions defines the reschedule workflow as a state machine.
+// Rejected and processed are terminal so both outcomes stay on record.
+var transitions = map[model.RescheduleStatus][]model.RescheduleStatus{
+	model.RescheduleStatusRequested: {
+		model.RescheduleStatusApproved,
+		model.RescheduleStatusRejected,
+	},
+	model.RescheduleStatusApproved: {
+		model.RescheduleStatusProcessed,
+	},
+}
+
+// CanTransition reports whether a reschedule may move from one status to another.
+func CanTransition(from, to model.RescheduleStatus) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// reschedulableStatuses lists the booking statuses that can still be moved to another departure.
+var reschedulableStatuses = map[model.BookingStatus]bool{
+	model.BookingStatusBooked:    true,
+	model.BookingStatusConfirmed: true,
+}
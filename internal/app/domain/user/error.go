@@ -0,0 +1,151 @@
+package user
+
+import (
+	"math"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// User Error Constructors
+// ==========================================================
+
+// ErrUserNotFound creates a new error for missing user records.
+func ErrUserNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.UserNotFound,
+		"user not found",
+		err,
+		nil,
+	)
+}
+
+// ErrEmailExists creates a new error for registering an email that belongs to another user.
+func ErrEmailExists(err error, details map[string]any) *apperror.AppError {
+	return apperror.New(
+		apperror.EmailExists,
+		"email is already registered",
+		err,
+		details,
+	)
+}
+
+// ErrPhoneExists creates a new error for registering a phone number that belongs to another user.
+func ErrPhoneExists(err error, details map[string]any) *apperror.AppError {
+	return apperror.New(
+		apperror.PhoneExists,
+		"phone number is already registered",
+		err,
+		details,
+	)
+}
+
+// ==========================================================
+// Email Verification Error Constructors
+// ==========================================================
+
+// ErrInvalidVerificationLink creates a new error for a verification token that is malformed, tampered with
+// or issued for an email address the user no longer has.
+func ErrInvalidVerificationLink(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.BadRequest,
+		"invalid email verification link",
+		err,
+		nil,
+	)
+}
+
+// ErrVerificationLinkExpired creates a new error for a verification token used after its expiry.
+func ErrVerificationLinkExpired() *apperror.AppError {
+	return apperror.New(
+		apperror.BadRequest,
+		"email verification link has expired, please request a new one",
+		nil,
+		nil,
+	)
+}
+
+// ErrNoEmail creates a new error for requesting a verification email for a user without an email address.
+func ErrNoEmail() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"user has no email address to verify",
+		nil,
+		nil,
+	)
+}
+
+// ErrEmailAlreadyVerified creates a new error for requesting a verification email for a verified address.
+func ErrEmailAlreadyVerified() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"email address is already verified",
+		nil,
+		nil,
+	)
+}
+
+// ErrVerificationThrottled creates a new error for requesting verification emails too often.
+func ErrVerificationThrottled(retryAfter time.Duration) *apperror.AppError {
+	return apperror.New(
+		apperror.RateLimitExceeded,
+		"too many verification emails requested, please try again later",
+		nil,
+		map[string]any{"retry_after_seconds": int64(math.Ceil(retryAfter.Seconds()))},
+	)
+}
+
+// ErrVerificationEmailNotSent creates a new error for a verification email the mail service did not accept.
+func ErrVerificationEmailNotSent(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.ServiceUnavailable,
+		"verification email could not be sent, please try again later",
+		err,
+		nil,
+	)
+}
+
+// ErrUserForbidden creates a new error for users accessing another account.
+func ErrUserForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"users can only access their own account",
+		nil,
+		nil,
+	)
+}
+
+// ErrVerificationForbidden creates a new error for users requesting verification emails for another account.
+func ErrVerificationForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"users can only request verification emails for their own account",
+		nil,
+		nil,
+	)
+}
+
+// ==========================================================
+// Password Reset Error Constructors
+// ==========================================================
+
+// ErrInvalidResetLink creates a new error for a password reset token that is unknown or was already used.
+func ErrInvalidResetLink(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.BadRequest,
+		"invalid or already used password reset link",
+		err,
+		nil,
+	)
+}
+
+// ErrResetLinkExpired creates a new error for a password reset token used after its expiry.
+func ErrResetLinkExpired() *apperror.AppError {
+	return apperror.New(
+		apperror.BadRequest,
+		"password reset link has expired, please request a new one",
+		nil,
+		nil,
+	)
+}
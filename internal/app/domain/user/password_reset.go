@@ -0,0 +1,184 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/token"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// PasswordResetOption holds the settings of password resets.
+type PasswordResetOption struct {
+	URL            string        // Page the reset link opens; the token is added as the "token" query parameter
+	TTL            time.Duration // Time a reset link stays valid
+	ResendCooldown time.Duration // Minimum time between two reset emails to a user
+}
+
+// ForgotPassword emails a single-use password reset link to the user with the given email address.
+// It succeeds whether or not the address is registered, and sends the email in the background,
+// so neither the response nor its timing reveals which addresses have accounts. Requests within
+// the cooldown of a previous one are silently ignored.
+func (s *service) ForgotPassword(ctx context.Context, req payload.UserForgotPasswordRequest) error {
+	ctx, span := serviceTracer.Start(ctx, "ForgotPassword")
+	defer span.End()
+
+	var msg *contract.MailMessage
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		found, err := uow.UserRepository().FindByEmail(ctx, strings.TrimSpace(req.Email))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		// Lock the user so concurrent requests see each other's tokens
+		u, err := uow.UserRepository().FindByIDForUpdate(ctx, found.ID)
+		if err != nil {
+			return err
+		}
+
+		if u.Email == nil || (u.IsActive != nil && !*u.IsActive) {
+			return nil
+		}
+
+		now := time.Now()
+		latest, err := uow.PasswordResetTokenRepository().FindLatestUnused(ctx, u.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err == nil && latest.CreatedOn != nil && now.Before(latest.CreatedOn.Add(s.opt.PasswordReset.ResendCooldown)) {
+			return nil
+		}
+
+		raw, hash, err := token.NewOpaque()
+		if err != nil {
+			return err
+		}
+
+		expiresAt := now.Add(s.opt.PasswordReset.TTL)
+		_, err = uow.PasswordResetTokenRepository().Save(ctx, &model.PasswordResetToken{
+			UserID:    u.ID,
+			TokenHash: hash,
+			ExpiresOn: expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		link, err := linkWithToken(s.opt.PasswordReset.URL, raw)
+		if err != nil {
+			return err
+		}
+
+		msg = &contract.MailMessage{
+			To:      *u.Email,
+			Subject: "Reset your password",
+			Text: fmt.Sprintf("Hi %s,\n\nWe received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\n"+
+				"The link can be used once and expires on %s. If you did not request a reset, you can ignore this email.\n",
+				u.FullName, link, expiresAt.Format(time.RFC1123)),
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deliverInBackground(ctx, msg)
+
+	return nil
+}
+
+// ResetPassword sets a new password with the token from a reset link. The token, and every other
+// unused reset token of the user, can no longer be used afterwards, and all of the user's sessions
+// are ended so anyone holding the old password is logged out.
+func (s *service) ResetPassword(ctx context.Context, req payload.UserResetPasswordRequest) error {
+	ctx, span := serviceTracer.Start(ctx, "ResetPassword")
+	defer span.End()
+
+	hash, err := s.hasher.Hash(req.Password)
+	if err != nil {
+		return err
+	}
+
+	return s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		reset, err := uow.PasswordResetTokenRepository().FindByHashForUpdate(ctx, token.HashOpaque(req.Token))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidResetLink(err)
+			}
+
+			return err
+		}
+
+		now := time.Now()
+		if reset.UsedOn != nil {
+			return ErrInvalidResetLink(nil)
+		}
+		if !now.Before(reset.ExpiresOn) {
+			return ErrResetLinkExpired()
+		}
+
+		u, err := uow.UserRepository().FindByIDForUpdate(ctx, reset.UserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidResetLink(err)
+			}
+
+			return err
+		}
+
+		if u.IsActive != nil && !*u.IsActive {
+			return ErrInvalidResetLink(nil)
+		}
+
+		// Resetting is public, so the change is recorded as done by the user the link was sent to
+		ctx = actor.NewContext(ctx, actor.Actor{ID: u.ID, UID: u.UID, Name: u.FullName, Role: u.Role})
+
+		u.PasswordHash = &hash
+		_, err = uow.UserRepository().Update(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		err = uow.PasswordResetTokenRepository().UseAll(ctx, u.ID, now)
+		if err != nil {
+			return err
+		}
+
+		if s.endSessions != nil {
+			return s.endSessions(ctx, uow, u.ID, u.Role)
+		}
+
+		return nil
+	})
+}
+
+// deliverInBackground sends an email without waiting for it. The send is detached from the request,
+// keeping only its trace, and a failure is recorded on a span of its own.
+func (s *service) deliverInBackground(ctx context.Context, msg *contract.MailMessage) {
+	if msg == nil {
+		return
+	}
+
+	detached := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+	go func() {
+		ctx, span := serviceTracer.Start(detached, "DeliverInBackground")
+		defer span.End()
+
+		err := s.mailer.Send(ctx, *msg)
+		if err != nil {
+			span.RecordError(err)
+		}
+	}()
+}
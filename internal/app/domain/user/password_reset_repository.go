@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var passwordResetRepositoryTracer trace.Tracer = otel.Tracer("user.password_reset_repository")
+
+// PasswordResetTokenRepository implements the contract.PasswordResetTokenRepository interface.
+type PasswordResetTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPasswordResetTokenRepository creates a new password reset token repository instance.
+func NewPasswordResetTokenRepository(db *gorm.DB) *PasswordResetTokenRepository {
+	return &PasswordResetTokenRepository{db: db}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.PasswordResetTokenRepository = (*PasswordResetTokenRepository)(nil)
+
+// FindByHashForUpdate retrieves the password reset token with the given hash and locks the row until the transaction ends.
+// Used and expired tokens are returned as well.
+func (r *PasswordResetTokenRepository) FindByHashForUpdate(ctx context.Context, hash string) (*model.PasswordResetToken, error) {
+	ctx, span := passwordResetRepositoryTracer.Start(ctx, "FindByHashForUpdate")
+	defer span.End()
+
+	var data model.PasswordResetToken
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_on IS NULL AND token_hash = ?", hash).
+		First(&data).Error
+	return &data, err
+}
+
+// FindLatestUnused retrieves the most recently issued unused password reset token of a user.
+func (r *PasswordResetTokenRepository) FindLatestUnused(ctx context.Context, userID uint) (*model.PasswordResetToken, error) {
+	ctx, span := passwordResetRepositoryTracer.Start(ctx, "FindLatestUnused")
+	defer span.End()
+
+	var data model.PasswordResetToken
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND used_on IS NULL AND user_id = ?", userID).
+		Order("created_on DESC").
+		First(&data).Error
+	return &data, err
+}
+
+// Save persists a new password reset token record to the database.
+func (r *PasswordResetTokenRepository) Save(ctx context.Context, token *model.PasswordResetToken) (*model.PasswordResetToken, error) {
+	ctx, span := passwordResetRepositoryTracer.Start(ctx, "Save")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Create(token).Error
+	return token, err
+}
+
+// UseAll marks every unused password reset token of a user as used, so none of them can reset the password again.
+func (r *PasswordResetTokenRepository) UseAll(ctx context.Context, userID uint, at time.Time) error {
+	ctx, span := passwordResetRepositoryTracer.Start(ctx, "UseAll")
+	defer span.End()
+
+	return r.db.WithContext(ctx).
+		Model(&model.PasswordResetToken{}).
+		Where("deleted_on IS NULL AND used_on IS NULL AND user_id = ?", userID).
+		Update("used_on", at).Error
+}
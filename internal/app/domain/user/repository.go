@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("user.repository")
+
+// Repository implements the contract.UserRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.User, model.UserFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new user repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.User, model.UserFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.UserRepository = (*Repository)(nil)
+
+// FindByReferralCode retrieves the active user owning the given referral code.
+func (r *Repository) FindByReferralCode(ctx context.Context, code string) (*model.User, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByReferralCode")
+	defer span.End()
+
+	var data model.User
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND referral_code = ?", code).
+		First(&data).Error
+	return &data, err
+}
+
+// FindByLogin retrieves the active user whose email or phone number matches the given login identifier.
+// Emails are stored lowercased, so the identifier is compared case-insensitively against them.
+func (r *Repository) FindByLogin(ctx context.Context, login string) (*model.User, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByLogin")
+	defer span.End()
+
+	var data model.User
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND (email = LOWER(?) OR phone = ?)", login, login).
+		First(&data).Error
+	return &data, err
+}
+
+// FindByEmail retrieves the active user with the given email address.
+// Emails are stored lowercased, so the address is compared case-insensitively.
+func (r *Repository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByEmail")
+	defer span.End()
+
+	var data model.User
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND email = LOWER(?)", email).
+		First(&data).Error
+	return &data, err
+}
@@ -0,0 +1,22 @@
+package user
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers user-related routes to the provided router group.
+// Listing all users requires the user:list permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	users := router.Group("/users")
+
+	users.Post("/", handler.RegisterUser)
+	users.Get("/", authz.Require(rbac.UserList), handler.GetUsers)
+	users.Post("/verify-email", handler.VerifyEmail)
+	users.Post("/forgot-password", handler.ForgotPassword)
+	users.Post("/reset-password", handler.ResetPassword)
+	users.Get("/:id", handler.GetUser)
+	users.Patch("/:id", handler.UpdateUser)
+	users.Post("/:id/verification-email", handler.ResendVerificationEmail)
+}
@@ -0,0 +1,334 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("user.service")
+
+// ReferralFunc records that a newly registered user was referred by the owner of a referral code.
+// It is called within the registration transaction, so a rejected code rolls the registration back.
+type ReferralFunc func(ctx context.Context, uow contract.UnitOfWork, refereeID uint, code string) (*model.Referral, error)
+
+// SessionEndFunc ends every login session of a principal, so its access and refresh tokens stop working.
+// It is called within the password reset transaction.
+type SessionEndFunc func(ctx context.Context, uow contract.UnitOfWork, principalID uint, role string) error
+
+// Option holds the settings of the emails the user service sends.
+type Option struct {
+	Verification  VerificationOption
+	PasswordReset PasswordResetOption
+}
+
+type service struct {
+	uow         contract.UnitOfWork
+	mapper      contract.Mapper
+	hasher      contract.PasswordHasher
+	referral    ReferralFunc
+	endSessions SessionEndFunc
+	mailer      contract.MailSender
+	tokens      contract.SignedTokenManager
+	opt         Option
+}
+
+// NewService initializes a new instance of user service.
+func NewService(
+	uow contract.UnitOfWork,
+	mapper contract.Mapper,
+	hasher contract.PasswordHasher,
+	referral ReferralFunc,
+	endSessions SessionEndFunc,
+	mailer contract.MailSender,
+	tokens contract.SignedTokenManager,
+	opt Option,
+) *service {
+	return &service{
+		uow:         uow,
+		mapper:      mapper,
+		hasher:      hasher,
+		referral:    referral,
+		endSessions: endSessions,
+		mailer:      mailer,
+		tokens:      tokens,
+		opt:         opt,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.UserService = (*service)(nil)
+
+// RegisterUser handles the registration of a new customer account.
+// The password is stored as a one-way hash and the email lowercased, so contacts are unique regardless of case.
+// When a referral code is given, the referral is recorded in the same transaction.
+// Users registering with an email address are sent a verification link once the account is created.
+func (s *service) RegisterUser(ctx context.Context, req payload.UserRegisterRequest) (*payload.UserResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "RegisterUser")
+	defer span.End()
+
+	hash, err := s.hasher.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *model.User
+	var verificationMail *contract.MailMessage
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		created, err = uow.UserRepository().Save(ctx, &model.User{
+			FullName:     strings.TrimSpace(req.FullName),
+			Gender:       req.Gender,
+			Email:        normalizeEmail(req.Email),
+			Phone:        strings.TrimSpace(req.Phone),
+			PasswordHash: &hash,
+			Role:         model.UserRoleCustomer,
+			CreatedBy:    actor.FromContext(ctx).JSON(),
+		})
+		if err != nil {
+			return mapUniqueViolation(err)
+		}
+
+		if req.ReferralCode != nil && s.referral != nil {
+			_, err = s.referral(ctx, uow, created.ID, *req.ReferralCode)
+			if err != nil {
+				return err
+			}
+		}
+
+		if created.Email != nil {
+			verificationMail, err = s.prepareVerification(ctx, uow, created)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.deliverVerification(ctx, verificationMail)
+
+	return s.toUserResponse(created)
+}
+
+// GetAllUsers retrieves a list of users with support for pagination and filtering.
+func (s *service) GetAllUsers(ctx context.Context, req payload.UserGetAllRequest) ([]payload.UserResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllUsers")
+	defer span.End()
+
+	if req.UserFilter == nil {
+		req.UserFilter = &model.UserFilter{}
+	}
+
+	var count int64
+	var users []model.User
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.UserRepository().Count(groupCtx, req.UserFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		users, err = s.uow.UserRepository().FindAll(groupCtx, req.Page, req.Size, req.UserFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp []payload.UserResponse
+	err = s.mapper.ToResponse(users, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetUserByID retrieves a specific user by its unique identifier. Users can only view themselves.
+func (s *service) GetUserByID(ctx context.Context, id uint) (*payload.UserResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetUserByID")
+	defer span.End()
+
+	err := authorize(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.uow.UserRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toUserResponse(u)
+}
+
+// UpdateUser modifies an existing user's profile.
+// Changing the email or phone number to one used by another user fails with EmailExists or PhoneExists.
+// A new email address is unverified until the link sent to it is opened. Email changes are throttled like
+// verification resends, since each one sends a verification email. Users can only update themselves.
+func (s *service) UpdateUser(ctx context.Context, id uint, req payload.UserUpdateRequest) (*payload.UserResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "UpdateUser")
+	defer span.End()
+
+	err := authorize(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.uow.UserRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	if req.FullName != nil {
+		u.FullName = strings.TrimSpace(*req.FullName)
+	}
+	if req.Gender != nil {
+		u.Gender = *req.Gender
+	}
+	emailChanged := false
+	if req.Email != nil {
+		email := normalizeEmail(req.Email)
+		emailChanged = u.Email == nil || *u.Email != *email
+		u.Email = email
+	}
+	if req.Phone != nil {
+		u.Phone = strings.TrimSpace(*req.Phone)
+	}
+
+	now := time.Now()
+	u.ModifiedOn = &now
+	u.ModifiedBy = actor.FromContext(ctx).JSON()
+
+	var updated *model.User
+	var verificationMail *contract.MailMessage
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		if emailChanged {
+			// Lock the user so concurrent changes are throttled one after another
+			_, err := uow.UserRepository().FindByIDForUpdate(ctx, u.ID)
+			if err != nil {
+				return err
+			}
+
+			sends, err := uow.EmailVerificationSendRepository().FindSince(ctx, u.ID, now.Add(-time.Hour))
+			if err != nil {
+				return err
+			}
+
+			if retryAfter := s.throttle(sends, now); retryAfter > 0 {
+				return ErrVerificationThrottled(retryAfter)
+			}
+		}
+
+		var err error
+		updated, err = uow.UserRepository().Update(ctx, u)
+		if err != nil {
+			return mapUniqueViolation(err)
+		}
+
+		if emailChanged {
+			verificationMail, err = s.prepareVerification(ctx, uow, updated)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.deliverVerification(ctx, verificationMail)
+
+	return s.toUserResponse(updated)
+}
+
+// authorize checks that the actor may view or update the given user.
+// Users can only access themselves and partners none; staff and the SYSTEM actor can access anyone.
+func authorize(ctx context.Context, userID uint) error {
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.UserRoleCustomer, model.UserRoleMuthawif:
+		if a.ID != userID {
+			return ErrUserForbidden()
+		}
+	case model.AdminRoleAgent, model.AdminRoleFinInst:
+		return ErrUserForbidden()
+	}
+
+	return nil
+}
+
+// toUserResponse maps a user to the response DTO.
+func (s *service) toUserResponse(u *model.User) (*payload.UserResponse, error) {
+	var resp payload.UserResponse
+	err := s.mapper.ToResponse(u, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// mapUniqueViolation translates a unique violation on the user's contacts into EmailExists or PhoneExists,
+// based on the conflicting column reported by the database. Other unique violations map to DuplicateEntry
+// and any other error is returned as is.
+func mapUniqueViolation(err error) error {
+	pgErr := dberror.GetError(err)
+	if pgErr == nil || pgErr.Code != dberror.UniqueViolation {
+		return err
+	}
+
+	msg, details := dberror.ParseUniqueConstraintError(pgErr)
+	if _, ok := details["email"]; ok {
+		return ErrEmailExists(err, details)
+	}
+	if _, ok := details["phone"]; ok {
+		return ErrPhoneExists(err, details)
+	}
+
+	return apperror.New(apperror.DuplicateEntry, msg, err, details)
+}
+
+// normalizeEmail trims and lowercases an optional email address.
+func normalizeEmail(email *string) *string {
+	if email == nil {
+		return nil
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(*email))
+	return &normalized
+}
@@ -0,0 +1,265 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/token"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// verificationPurpose binds verification tokens to email verification.
+const verificationPurpose = "email_verification"
+
+// VerificationOption holds the settings of email verification.
+type VerificationOption struct {
+	URL             string        // Page the verification link opens; the token is added as the "token" query parameter
+	TTL             time.Duration // Time a verification link stays valid
+	ResendCooldown  time.Duration // Minimum time between two verification emails to a user
+	MaxSendsPerHour int           // Most verification emails sent to a user within an hour, 0 for no limit
+}
+
+// VerifyEmail verifies the email address a verification link was sent to by stamping it into "verified_by".
+// Links are signed and expire; a link for an address the user has since changed is rejected.
+// Verifying an address that is already verified succeeds without changes.
+func (s *service) VerifyEmail(ctx context.Context, req payload.UserVerifyEmailRequest) (*payload.UserResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "VerifyEmail")
+	defer span.End()
+
+	subject, err := s.tokens.Verify(verificationPurpose, req.Token)
+	if err != nil {
+		if errors.Is(err, token.ErrExpired) {
+			return nil, ErrVerificationLinkExpired()
+		}
+
+		return nil, ErrInvalidVerificationLink(err)
+	}
+
+	id, email, err := parseVerificationSubject(subject)
+	if err != nil {
+		return nil, ErrInvalidVerificationLink(err)
+	}
+
+	var verified *model.User
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		u, err := uow.UserRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidVerificationLink(err)
+			}
+
+			return err
+		}
+
+		if u.Email == nil || *u.Email != email {
+			return ErrInvalidVerificationLink(nil)
+		}
+
+		if u.EmailVerified() {
+			verified = u
+			return nil
+		}
+
+		// The link proves the user holds the address, so the user is recorded as the verifier
+		principal := actor.Actor{UID: u.UID, Name: u.FullName}
+		u.VerifiedBy, err = json.Marshal(model.UserVerification{
+			UserUID:    principal.UID,
+			UserName:   principal.Name,
+			Email:      email,
+			VerifiedOn: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		verified, err = uow.UserRepository().Update(actor.NewContext(ctx, principal), u)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toUserResponse(verified)
+}
+
+// ResendVerificationEmail sends a new verification link to a user's unverified email address.
+// Users can only request one for themselves, and sends are throttled by a cooldown and an hourly limit.
+func (s *service) ResendVerificationEmail(ctx context.Context, id uint) error {
+	ctx, span := serviceTracer.Start(ctx, "ResendVerificationEmail")
+	defer span.End()
+
+	err := authorizeVerification(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var msg *contract.MailMessage
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		// Lock the user so concurrent requests are throttled one after another
+		u, err := uow.UserRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrUserNotFound(err)
+			}
+
+			return err
+		}
+
+		if u.Email == nil {
+			return ErrNoEmail()
+		}
+		if u.EmailVerified() {
+			return ErrEmailAlreadyVerified()
+		}
+
+		now := time.Now()
+		sends, err := uow.EmailVerificationSendRepository().FindSince(ctx, u.ID, now.Add(-time.Hour))
+		if err != nil {
+			return err
+		}
+
+		if retryAfter := s.throttle(sends, now); retryAfter > 0 {
+			return ErrVerificationThrottled(retryAfter)
+		}
+
+		msg, err = s.prepareVerification(ctx, uow, u)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.mailer.Send(ctx, *msg)
+	if err != nil {
+		return ErrVerificationEmailNotSent(err)
+	}
+
+	return nil
+}
+
+// prepareVerification signs a verification link for the user's email address and records the send
+// within the caller's transaction. It returns the email to send once the transaction is committed.
+func (s *service) prepareVerification(ctx context.Context, uow contract.UnitOfWork, u *model.User) (*contract.MailMessage, error) {
+	signed, expiresAt, err := s.tokens.Sign(verificationPurpose, verificationSubject(u.ID, *u.Email), s.opt.Verification.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uow.EmailVerificationSendRepository().Save(ctx, &model.EmailVerificationSend{
+		UserID:    u.ID,
+		Email:     *u.Email,
+		ExpiresOn: expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := linkWithToken(s.opt.Verification.URL, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contract.MailMessage{
+		To:      *u.Email,
+		Subject: "Verify your email address",
+		Text: fmt.Sprintf("Hi %s,\n\nPlease verify your email address by opening the link below:\n\n%s\n\n"+
+			"The link expires on %s. If you did not create an account, you can ignore this email.\n",
+			u.FullName, link, expiresAt.Format(time.RFC1123)),
+	}, nil
+}
+
+// deliverVerification sends a verification email on a best-effort basis after the change that triggered it
+// was committed. A failure is recorded on the span only, since the user can request a new email.
+func (s *service) deliverVerification(ctx context.Context, msg *contract.MailMessage) {
+	if msg == nil {
+		return
+	}
+
+	err := s.mailer.Send(ctx, *msg)
+	if err != nil {
+		trace.SpanFromContext(ctx).RecordError(err)
+	}
+}
+
+// throttle returns how long the user has to wait before another verification email can be sent,
+// given the emails sent within the last hour, most recent first. It returns zero when one can be sent now.
+func (s *service) throttle(sends []model.EmailVerificationSend, now time.Time) time.Duration {
+	var retryAfter time.Duration
+
+	if len(sends) > 0 && sends[0].CreatedOn != nil {
+		retryAfter = max(retryAfter, sends[0].CreatedOn.Add(s.opt.Verification.ResendCooldown).Sub(now))
+	}
+
+	limit := s.opt.Verification.MaxSendsPerHour
+	if limit > 0 && len(sends) >= limit {
+		// Wait until enough of the sends leave the hour window to fall below the limit.
+		// Sends are most recent first, so the limit-th most recent is the last of them to leave.
+		last := sends[limit-1]
+		if last.CreatedOn != nil {
+			retryAfter = max(retryAfter, last.CreatedOn.Add(time.Hour).Sub(now))
+		}
+	}
+
+	return retryAfter
+}
+
+// authorizeVerification checks that the actor may request a verification email for the given user.
+// Users can only request one for themselves and partners none; staff and the SYSTEM actor can for anyone.
+func authorizeVerification(ctx context.Context, userID uint) error {
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.UserRoleCustomer, model.UserRoleMuthawif:
+		if a.ID != userID {
+			return ErrVerificationForbidden()
+		}
+	case model.AdminRoleAgent, model.AdminRoleFinInst:
+		return ErrVerificationForbidden()
+	}
+
+	return nil
+}
+
+// verificationSubject returns the subject of a verification token: the user ID and the address it verifies.
+func verificationSubject(userID uint, email string) string {
+	return strconv.FormatUint(uint64(userID), 10) + ":" + email
+}
+
+// parseVerificationSubject splits the subject of a verification token into the user ID and email address.
+func parseVerificationSubject(subject string) (uint, string, error) {
+	rawID, email, found := strings.Cut(subject, ":")
+	if !found || email == "" {
+		return 0, "", fmt.Errorf("malformed verification subject %q", subject)
+	}
+
+	id, err := strconv.ParseUint(rawID, 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("malformed verification subject %q: %w", subject, err)
+	}
+
+	return uint(id), email, nil
+}
+
+// linkWithToken adds the token to the URL of the page a link opens as the "token" query parameter.
+func linkWithToken(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("invalid link URL %q: %w", base, err)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var verificationRepositoryTracer trace.Tracer = otel.Tracer("user.verification_repository")
+
+// VerificationSendRepository implements the contract.EmailVerificationSendRepository interface.
+type VerificationSendRepository struct {
+	db *gorm.DB
+}
+
+// NewVerificationSendRepository creates a new email verification send repository instance.
+func NewVerificationSendRepository(db *gorm.DB) *VerificationSendRepository {
+	return &VerificationSendRepository{db: db}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.EmailVerificationSendRepository = (*VerificationSendRepository)(nil)
+
+// FindSince retrieves the verification emails sent to a user since the given time, the most recent first.
+func (r *VerificationSendRepository) FindSince(ctx context.Context, userID uint, since time.Time) ([]model.EmailVerificationSend, error) {
+	ctx, span := verificationRepositoryTracer.Start(ctx, "FindSince")
+	defer span.End()
+
+	var data []model.EmailVerificationSend
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND user_id = ? AND created_on >= ?", userID, since).
+		Order("created_on DESC").
+		Find(&data).Error
+	return data, err
+}
+
+// Save persists a new verification email send record to the database.
+func (r *VerificationSendRepository) Save(ctx context.Context, send *model.EmailVerificationSend) (*model.EmailVerificationSend, error) {
+	ctx, span := verificationRepositoryTracer.Start(ctx, "Save")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Create(send).Error
+	return send, err
+}
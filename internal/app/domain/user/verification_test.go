@@ -0,0 +1,225 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/apptest"
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/mapper"
+	"github.com/aburizalpurnama/travel/internal/pkg/token"
+)
+
+func TestThrottle(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s := &service{opt: Option{Verification: VerificationOption{ResendCooldown: time.Minute, MaxSendsPerHour: 3}}}
+
+	// sentAgo lists sends by how long ago they were made, most recent first
+	sentAgo := func(ago ...time.Duration) []model.EmailVerificationSend {
+		sends := make([]model.EmailVerificationSend, len(ago))
+		for i, d := range ago {
+			at := now.Add(-d)
+			sends[i].CreatedOn = &at
+		}
+		return sends
+	}
+
+	tests := []struct {
+		name  string
+		sends []model.EmailVerificationSend
+		want  time.Duration
+	}{
+		{"first send", nil, 0},
+		{"within cooldown", sentAgo(20 * time.Second), 40 * time.Second},
+		{"cooldown passed", sentAgo(time.Minute), 0},
+		{"below hourly limit", sentAgo(2*time.Minute, 10*time.Minute), 0},
+		{"hourly limit reached", sentAgo(2*time.Minute, 10*time.Minute, 50*time.Minute), 10 * time.Minute},
+		{"hourly limit over cooldown", sentAgo(30*time.Second, 40*time.Minute, 45*time.Minute), 15 * time.Minute},
+		{"hourly limit with more sends", sentAgo(2*time.Minute, 5*time.Minute, 20*time.Minute, 55*time.Minute), 40 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.throttle(tt.sends, now); got != tt.want {
+				t.Errorf("throttle() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no hourly limit", func(t *testing.T) {
+		s := &service{opt: Option{Verification: VerificationOption{ResendCooldown: time.Minute}}}
+		if got := s.throttle(sentAgo(2*time.Minute, 3*time.Minute, 4*time.Minute, 5*time.Minute), now); got != 0 {
+			t.Errorf("throttle() = %s, want 0", got)
+		}
+	})
+}
+
+func TestResendVerificationEmail(t *testing.T) {
+	email := "siti@example.com"
+	customer := actor.Actor{ID: 7, UID: "u-7", Role: model.UserRoleCustomer}
+
+	tests := []struct {
+		name      string
+		actor     actor.Actor
+		sentAgo   []time.Duration
+		want      *apperror.AppError
+		wantSends int
+	}{
+		{"sent", customer, nil, nil, 1},
+		{"sent after cooldown", customer, []time.Duration{2 * time.Minute}, nil, 2},
+		{"throttled", customer, []time.Duration{10 * time.Second}, ErrVerificationThrottled(50 * time.Second), 1},
+		{"staff for a user", actor.Actor{ID: 1, Role: model.AdminRoleAdmin}, nil, nil, 1},
+		{"another user", actor.Actor{ID: 8, Role: model.UserRoleCustomer}, nil, ErrVerificationForbidden(), 0},
+		{"agent", actor.Actor{ID: 7, Role: model.AdminRoleAgent}, nil, ErrVerificationForbidden(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uow := newFakeUoW(model.User{ID: 7, FullName: "Siti", Email: &email})
+			for _, ago := range tt.sentAgo {
+				at := time.Now().Add(-ago)
+				uow.sends.sends = append(uow.sends.sends, model.EmailVerificationSend{UserID: 7, Email: email, CreatedOn: &at})
+			}
+			mailer := &fakeMailer{}
+			s := NewService(uow, nil, nil, nil, nil, mailer, token.NewSignedTokenManager("secret"), Option{
+				Verification: VerificationOption{
+					URL:             "https://example.com/verify",
+					TTL:             time.Hour,
+					ResendCooldown:  time.Minute,
+					MaxSendsPerHour: 3,
+				},
+			})
+
+			err := s.ResendVerificationEmail(actor.NewContext(context.Background(), tt.actor), 7)
+			apptest.AssertError(t, err, tt.want)
+
+			if len(uow.sends.sends) != tt.wantSends {
+				t.Errorf("recorded sends = %d, want %d", len(uow.sends.sends), tt.wantSends)
+			}
+			if wantMails := tt.wantSends - len(tt.sentAgo); len(mailer.sent) != max(wantMails, 0) {
+				t.Errorf("mails sent = %d, want %d", len(mailer.sent), max(wantMails, 0))
+			}
+		})
+	}
+}
+
+func TestUpdateUserEmailThrottled(t *testing.T) {
+	email, newEmail := "siti@example.com", "siti.rahma@example.com"
+	ctx := actor.NewContext(context.Background(), actor.Actor{ID: 7, UID: "u-7", Role: model.UserRoleCustomer})
+
+	tests := []struct {
+		name      string
+		sentAgo   []time.Duration
+		want      *apperror.AppError
+		wantEmail string
+	}{
+		{"changed", []time.Duration{2 * time.Minute}, nil, newEmail},
+		{"throttled", []time.Duration{10 * time.Second}, ErrVerificationThrottled(50 * time.Second), email},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uow := newFakeUoW(model.User{ID: 7, FullName: "Siti", Email: &email})
+			for _, ago := range tt.sentAgo {
+				at := time.Now().Add(-ago)
+				uow.sends.sends = append(uow.sends.sends, model.EmailVerificationSend{UserID: 7, Email: email, CreatedOn: &at})
+			}
+			mailer := &fakeMailer{}
+			s := NewService(uow, mapper.NewCopierMapper(), nil, nil, nil, mailer, token.NewSignedTokenManager("secret"), Option{
+				Verification: VerificationOption{
+					URL:             "https://example.com/verify",
+					TTL:             time.Hour,
+					ResendCooldown:  time.Minute,
+					MaxSendsPerHour: 3,
+				},
+			})
+
+			_, err := s.UpdateUser(ctx, 7, payload.UserUpdateRequest{Email: &newEmail})
+			apptest.AssertError(t, err, tt.want)
+
+			if *uow.users.user.Email != tt.wantEmail {
+				t.Errorf("email = %s, want %s", *uow.users.user.Email, tt.wantEmail)
+			}
+			if wantMails := len(uow.sends.sends) - len(tt.sentAgo); len(mailer.sent) != wantMails {
+				t.Errorf("mails sent = %d, want %d", len(mailer.sent), wantMails)
+			}
+		})
+	}
+}
+
+type fakeUoW struct {
+	*apptest.UnitOfWork
+	users *fakeUserRepository
+	sends *fakeSendRepository
+}
+
+func newFakeUoW(user model.User) *fakeUoW {
+	u := &fakeUoW{
+		users: &fakeUserRepository{user: user},
+		sends: &fakeSendRepository{},
+	}
+	u.UnitOfWork = &apptest.UnitOfWork{Users: u.users, EmailVerificationSends: u.sends}
+	return u
+}
+
+type fakeUserRepository struct {
+	contract.UserRepository
+	user model.User
+}
+
+func (r *fakeUserRepository) FindByID(ctx context.Context, id uint) (*model.User, error) {
+	return r.FindByIDForUpdate(ctx, id)
+}
+
+func (r *fakeUserRepository) FindByIDForUpdate(_ context.Context, id uint) (*model.User, error) {
+	if id != r.user.ID {
+		return nil, errors.New("unexpected user")
+	}
+
+	u := r.user
+	return &u, nil
+}
+
+func (r *fakeUserRepository) Update(_ context.Context, u *model.User) (*model.User, error) {
+	r.user = *u
+	return u, nil
+}
+
+// fakeSendRepository keeps the recorded sends most recent first, as the real repository returns them.
+type fakeSendRepository struct {
+	contract.EmailVerificationSendRepository
+	sends []model.EmailVerificationSend
+}
+
+func (r *fakeSendRepository) FindSince(_ context.Context, userID uint, since time.Time) ([]model.EmailVerificationSend, error) {
+	var found []model.EmailVerificationSend
+	for _, send := range r.sends {
+		if send.UserID == userID && send.CreatedOn.After(since) {
+			found = append(found, send)
+		}
+	}
+	return found, nil
+}
+
+func (r *fakeSendRepository) Save(_ context.Context, send *model.EmailVerificationSend) (*model.EmailVerificationSend, error) {
+	now := time.Now()
+	saved := *send
+	saved.CreatedOn = &now
+	r.sends = append([]model.EmailVerificationSend{saved}, r.sends...)
+	return &saved, nil
+}
+
+type fakeMailer struct {
+	sent []contract.MailMessage
+}
+
+func (m *fakeMailer) Send(_ context.Context, msg contract.MailMessage) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
@@ -0,0 +1,111 @@
+package voucher
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Voucher Error Constructors
+// ==========================================================
+
+// ErrVoucherNotFound creates a new error for missing voucher records.
+func ErrVoucherNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"voucher not found",
+		err,
+		nil,
+	)
+}
+
+// ErrVoucherExpired creates a new error for vouchers used outside their validity window.
+func ErrVoucherExpired(validFrom, validUntil time.Time) *apperror.AppError {
+	return apperror.New(
+		apperror.VoucherExpired,
+		"voucher is not valid at this time",
+		nil,
+		map[string]any{"valid_from": validFrom, "valid_until": validUntil},
+	)
+}
+
+// ErrVoucherInactive creates a new error for vouchers that have been deactivated.
+func ErrVoucherInactive() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"voucher is no longer available",
+		nil,
+		map[string]any{"voucher_code": apperror.InvalidValue},
+	)
+}
+
+// ErrVoucherNotApplicable creates a new error for vouchers that do not cover the booked product.
+func ErrVoucherNotApplicable() *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"voucher does not apply to this product",
+		nil,
+		map[string]any{"voucher_code": apperror.InvalidValue},
+	)
+}
+
+// ErrMinOrderNotMet creates a new error for orders below the minimum amount required by a voucher.
+func ErrMinOrderNotMet(minOrderAmount string) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"order amount is below the voucher minimum of "+minOrderAmount,
+		nil,
+		map[string]any{"voucher_code": apperror.InvalidValue},
+	)
+}
+
+// ErrUsageLimitReached creates a new error for vouchers that have been fully redeemed.
+func ErrUsageLimitReached() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"voucher usage limit has been reached",
+		nil,
+		nil,
+	)
+}
+
+// ErrPerUserLimitReached creates a new error for users who have already redeemed a voucher as often as allowed.
+func ErrPerUserLimitReached() *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"voucher has already been used the maximum number of times by this user",
+		nil,
+		nil,
+	)
+}
+
+// ErrInvalidValue creates a new error for voucher fields holding an invalid amount or date range.
+func ErrInvalidValue(field string, err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		err,
+		map[string]any{field: apperror.InvalidValue},
+	)
+}
+
+// ErrUsageLimitBelowUsed creates a new error for lowering a usage limit below the current usage.
+func ErrUsageLimitBelowUsed(usedCount int) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"usage limit cannot be lower than the current usage",
+		nil,
+		map[string]any{"usage_limit": apperror.ValueTooLow, "used_count": usedCount},
+	)
+}
+
+// ErrProductsNotFound creates a new error for product restrictions referencing missing products.
+func ErrProductsNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"one or more products do not exist",
+		err,
+		map[string]any{"product_ids": apperror.InvalidValue},
+	)
+}
@@ -0,0 +1,142 @@
+package voucher
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+// NormalizeCode returns the canonical form of a voucher code; codes are case-insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Discount computes the discount a voucher grants on the given subtotal.
+// Percentage discounts are rounded to two decimals and capped by MaxDiscount;
+// the discount never exceeds the subtotal.
+func Discount(v *model.Voucher, subtotal decimal.Decimal) decimal.Decimal {
+	var discount decimal.Decimal
+	switch v.DiscountType {
+	case model.VoucherDiscountPercentage:
+		discount = subtotal.Mul(v.DiscountValue).Div(hundred).Round(2)
+		if v.MaxDiscount != nil && discount.GreaterThan(*v.MaxDiscount) {
+			discount = *v.MaxDiscount
+		}
+	default:
+		discount = v.DiscountValue
+	}
+
+	if discount.GreaterThan(subtotal) {
+		discount = subtotal
+	}
+	return discount
+}
+
+// Redemption is a voucher claimed for a booking that is being created.
+// Record must be called once the booking has been saved.
+type Redemption struct {
+	Voucher  *model.Voucher
+	UserID   uint
+	Discount decimal.Decimal
+}
+
+// Redeem validates a voucher for a user ordering a product and claims one use of it.
+// The voucher row is locked for the rest of the transaction so the per-user limit is checked
+// against a stable set of redemptions, and the usage counter is incremented with a conditional
+// update so concurrent redemptions can never exceed the usage limit. It must be called within a transaction.
+func Redeem(ctx context.Context, uow contract.UnitOfWork, code string, userID, productID uint, subtotal decimal.Decimal) (*Redemption, error) {
+	v, err := uow.VoucherRepository().FindByCodeForUpdate(ctx, NormalizeCode(code))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrVoucherNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	err = checkApplicable(v, productID, subtotal, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if v.PerUserLimit != nil {
+		used, err := uow.VoucherRedemptionRepository().Count(ctx, &model.VoucherRedemptionFilter{
+			VoucherID: &v.ID,
+			UserID:    &userID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if used >= int64(*v.PerUserLimit) {
+			return nil, ErrPerUserLimitReached()
+		}
+	}
+
+	ok, err := uow.VoucherRepository().IncrementUsedCount(ctx, v.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrUsageLimitReached()
+	}
+	v.UsedCount++
+
+	return &Redemption{Voucher: v, UserID: userID, Discount: Discount(v, subtotal)}, nil
+}
+
+// Record persists the redemption against the booking it was applied to.
+// It must be called within the same transaction as Redeem.
+func (r *Redemption) Record(ctx context.Context, uow contract.UnitOfWork, bookingID uint) error {
+	_, err := uow.VoucherRedemptionRepository().Save(ctx, &model.VoucherRedemption{
+		VoucherID:      r.Voucher.ID,
+		BookingID:      bookingID,
+		UserID:         r.UserID,
+		DiscountAmount: r.Discount,
+		CreatedBy:      actor.FromContext(ctx).JSON(),
+	})
+	return err
+}
+
+// checkApplicable validates a voucher against its status, validity window, product restrictions and minimum order.
+// Usage limits are checked separately since they depend on other redemptions.
+func checkApplicable(v *model.Voucher, productID uint, subtotal decimal.Decimal, now time.Time) error {
+	if v.IsActive != nil && !*v.IsActive {
+		return ErrVoucherInactive()
+	}
+
+	if now.Before(v.ValidFrom) || !now.Before(v.ValidUntil) {
+		return ErrVoucherExpired(v.ValidFrom, v.ValidUntil)
+	}
+
+	if len(v.Products) > 0 {
+		applies := false
+		for _, p := range v.Products {
+			if p.ProductID == productID {
+				applies = true
+				break
+			}
+		}
+		if !applies {
+			return ErrVoucherNotApplicable()
+		}
+	}
+
+	if subtotal.LessThan(v.MinOrderAmount) {
+		return ErrMinOrderNotMet(v.MinOrderAmount.StringFixed(2))
+	}
+
+	if v.UsageLimit != nil && v.UsedCount >= *v.UsageLimit {
+		return ErrUsageLimitReached()
+	}
+
+	return nil
+}
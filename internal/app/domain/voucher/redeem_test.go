@@ -0,0 +1,177 @@
+package voucher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/apptest"
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/shopspring/decimal"
+)
+
+func TestDiscount(t *testing.T) {
+	maxDiscount := decimal.NewFromInt(150)
+
+	tests := []struct {
+		name     string
+		voucher  model.Voucher
+		subtotal string
+		want     string
+	}{
+		{"percentage", model.Voucher{DiscountType: model.VoucherDiscountPercentage, DiscountValue: decimal.NewFromInt(10)}, "1000", "100"},
+		{"percentage rounded", model.Voucher{DiscountType: model.VoucherDiscountPercentage, DiscountValue: decimal.NewFromInt(15)}, "100.05", "15.01"},
+		{"percentage capped", model.Voucher{DiscountType: model.VoucherDiscountPercentage, DiscountValue: decimal.NewFromInt(20), MaxDiscount: &maxDiscount}, "1000", "150"},
+		{"percentage below cap", model.Voucher{DiscountType: model.VoucherDiscountPercentage, DiscountValue: decimal.NewFromInt(10), MaxDiscount: &maxDiscount}, "1000", "100"},
+		{"fixed", model.Voucher{DiscountType: model.VoucherDiscountFixed, DiscountValue: decimal.NewFromInt(250)}, "1000", "250"},
+		{"fixed above subtotal", model.Voucher{DiscountType: model.VoucherDiscountFixed, DiscountValue: decimal.NewFromInt(250)}, "200", "200"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Discount(&tt.voucher, decimal.RequireFromString(tt.subtotal))
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("Discount() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckApplicable(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	inactive := false
+	limit := 5
+
+	valid := func() model.Voucher {
+		return model.Voucher{
+			ValidFrom:      now.AddDate(0, 0, -1),
+			ValidUntil:     now.AddDate(0, 0, 1),
+			MinOrderAmount: decimal.NewFromInt(500),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(v *model.Voucher)
+		want   *apperror.AppError
+	}{
+		{"applicable", func(v *model.Voucher) {}, nil},
+		{"inactive", func(v *model.Voucher) { v.IsActive = &inactive }, ErrVoucherInactive()},
+		{"not started", func(v *model.Voucher) { v.ValidFrom = now.Add(time.Minute) }, ErrVoucherExpired(now, now)},
+		{"ended", func(v *model.Voucher) { v.ValidUntil = now }, ErrVoucherExpired(now, now)},
+		{"restricted to product", func(v *model.Voucher) { v.Products = []model.VoucherProduct{{ProductID: 1}} }, nil},
+		{"restricted to other products", func(v *model.Voucher) { v.Products = []model.VoucherProduct{{ProductID: 2}, {ProductID: 3}} }, ErrVoucherNotApplicable()},
+		{"below minimum order", func(v *model.Voucher) { v.MinOrderAmount = decimal.NewFromInt(1001) }, ErrMinOrderNotMet("1001.00")},
+		{"usage limit left", func(v *model.Voucher) { v.UsageLimit, v.UsedCount = &limit, 4 }, nil},
+		{"usage limit reached", func(v *model.Voucher) { v.UsageLimit, v.UsedCount = &limit, 5 }, ErrUsageLimitReached()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := valid()
+			tt.modify(&v)
+
+			err := checkApplicable(&v, 1, decimal.NewFromInt(1000), now)
+			apptest.AssertError(t, err, tt.want)
+		})
+	}
+}
+
+func TestRedeemPerUserLimit(t *testing.T) {
+	perUser := 2
+
+	tests := []struct {
+		name string
+		used int64
+		want *apperror.AppError
+	}{
+		{"first use", 0, nil},
+		{"last use", 1, nil},
+		{"limit reached", 2, ErrPerUserLimitReached()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := activeVoucher()
+			v.PerUserLimit = &perUser
+			uow := newFakeUoW(v)
+			uow.redemptions.used = tt.used
+
+			r, err := Redeem(context.Background(), uow, " save10 ", 7, 1, decimal.NewFromInt(1000))
+			apptest.AssertError(t, err, tt.want)
+			if err != nil {
+				if uow.vouchers.used != 0 {
+					t.Errorf("used count = %d after a rejected redemption, want 0", uow.vouchers.used)
+				}
+				return
+			}
+
+			if !r.Discount.Equal(decimal.NewFromInt(100)) || r.UserID != 7 || uow.vouchers.used != 1 {
+				t.Errorf("redemption = %+v with used count %d, want a discount of 100 and one use", r, uow.vouchers.used)
+			}
+		})
+	}
+}
+
+func activeVoucher() model.Voucher {
+	return model.Voucher{
+		ID:            1,
+		Code:          "SAVE10",
+		DiscountType:  model.VoucherDiscountPercentage,
+		DiscountValue: decimal.NewFromInt(10),
+		ValidFrom:     time.Now().AddDate(0, 0, -1),
+		ValidUntil:    time.Now().AddDate(0, 0, 1),
+	}
+}
+
+type fakeUoW struct {
+	*apptest.UnitOfWork
+	vouchers    *fakeVoucherRepository
+	redemptions *fakeRedemptionRepository
+}
+
+func newFakeUoW(v model.Voucher) *fakeUoW {
+	u := &fakeUoW{
+		vouchers:    &fakeVoucherRepository{voucher: v},
+		redemptions: &fakeRedemptionRepository{},
+	}
+	u.UnitOfWork = &apptest.UnitOfWork{Vouchers: u.vouchers, VoucherRedemptions: u.redemptions}
+	return u
+}
+
+// fakeVoucherRepository holds a single voucher and counts its uses with the same guard
+// as the conditional update of the real repository, which TestIncrementUsedCount covers.
+type fakeVoucherRepository struct {
+	contract.VoucherRepository
+	voucher model.Voucher
+	used    int
+}
+
+func (r *fakeVoucherRepository) FindByCodeForUpdate(_ context.Context, code string) (*model.Voucher, error) {
+	if code != r.voucher.Code {
+		return nil, errors.New("unexpected voucher code " + code)
+	}
+
+	v := r.voucher
+	return &v, nil
+}
+
+func (r *fakeVoucherRepository) IncrementUsedCount(_ context.Context, _ uint) (bool, error) {
+	if r.voucher.UsageLimit != nil && r.used >= *r.voucher.UsageLimit {
+		return false, nil
+	}
+	r.used++
+	return true, nil
+}
+
+type fakeRedemptionRepository struct {
+	contract.VoucherRedemptionRepository
+	used int64
+}
+
+func (r *fakeRedemptionRepository) Count(_ context.Context, _ *model.VoucherRedemptionFilter) (int64, error) {
+	return r.used, nil
+}
@@ -0,0 +1,156 @@
+package voucher
+
+import (
+	"context"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("voucher.repository")
+
+// Repository implements the contract.VoucherRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Voucher, model.VoucherFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new voucher repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Voucher, model.VoucherFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.VoucherRepository = (*Repository)(nil)
+
+// FindAll retrieves a list of vouchers based on pagination and filter criteria,
+// loading the product restrictions of the whole page in a single query.
+func (r *Repository) FindAll(ctx context.Context, page *int, size *int, filter *model.VoucherFilter) ([]model.Voucher, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindAll")
+	defer span.End()
+
+	data, err := r.GORM.FindAll(ctx, page, size, filter)
+	if err != nil || len(data) == 0 {
+		return data, err
+	}
+
+	ids := make([]uint, 0, len(data))
+	for _, v := range data {
+		ids = append(ids, v.ID)
+	}
+
+	var products []model.VoucherProduct
+	err = r.db.WithContext(ctx).Where("voucher_id IN ?", ids).Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byVoucher := make(map[uint][]model.VoucherProduct, len(data))
+	for _, p := range products {
+		byVoucher[p.VoucherID] = append(byVoucher[p.VoucherID], p)
+	}
+	for i := range data {
+		data[i].Products = byVoucher[data[i].ID]
+	}
+
+	return data, nil
+}
+
+// FindByID retrieves a single voucher by its unique identifier, including its product restrictions.
+func (r *Repository) FindByID(ctx context.Context, id uint) (*model.Voucher, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	var data model.Voucher
+	err := r.db.WithContext(ctx).
+		Preload("Products").
+		Where("deleted_on IS NULL").
+		First(&data, id).Error
+	return &data, err
+}
+
+// FindByCodeForUpdate retrieves a single voucher by its code, including its product restrictions,
+// and locks the row until the transaction ends. It must be called within a transaction.
+func (r *Repository) FindByCodeForUpdate(ctx context.Context, code string) (*model.Voucher, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByCodeForUpdate")
+	defer span.End()
+
+	var data model.Voucher
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Products").
+		Where("deleted_on IS NULL AND code = ?", code).
+		First(&data).Error
+	return &data, err
+}
+
+// IncrementUsedCount atomically increments the usage counter of a voucher with a conditional update,
+// so the usage limit holds even if the caller did not lock the row.
+func (r *Repository) IncrementUsedCount(ctx context.Context, id uint) (bool, error) {
+	ctx, span := repositoryTracer.Start(ctx, "IncrementUsedCount")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.Voucher{}).
+		Where("id = ? AND deleted_on IS NULL AND (usage_limit IS NULL OR used_count < usage_limit)", id).
+		Updates(map[string]any{
+			"used_count":  gorm.Expr("used_count + 1"),
+			"modified_on": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// ReplaceProducts replaces the product restrictions of a voucher.
+func (r *Repository) ReplaceProducts(ctx context.Context, id uint, productIDs []uint) error {
+	ctx, span := repositoryTracer.Start(ctx, "ReplaceProducts")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).
+		Where("voucher_id = ?", id).
+		Delete(&model.VoucherProduct{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	products := make([]model.VoucherProduct, 0, len(productIDs))
+	for _, productID := range productIDs {
+		products = append(products, model.VoucherProduct{VoucherID: id, ProductID: productID})
+	}
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&products).Error
+}
+
+// RedemptionRepository implements the contract.VoucherRedemptionRepository interface.
+type RedemptionRepository struct {
+	*repository.GORM[model.VoucherRedemption, model.VoucherRedemptionFilter]
+}
+
+// NewRedemptionRepository creates a new voucher redemption repository instance.
+func NewRedemptionRepository(db *gorm.DB) *RedemptionRepository {
+	return &RedemptionRepository{
+		GORM: repository.NewGORM[model.VoucherRedemption, model.VoucherRedemptionFilter](db),
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.VoucherRedemptionRepository = (*RedemptionRepository)(nil)
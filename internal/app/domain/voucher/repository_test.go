@@ -0,0 +1,34 @@
+package voucher
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/app/apptest"
+)
+
+// TestIncrementUsedCount checks the statement behind voucher redemptions. Concurrent redemptions are kept
+// within the usage limit by the database evaluating the guard on the row it updates, so the guard must be
+// part of the UPDATE itself rather than checked against a previously read voucher.
+func TestIncrementUsedCount(t *testing.T) {
+	db := apptest.DryRunDB(t)
+	statements := apptest.CaptureUpdates(t, db)
+
+	_, err := NewRepository(db).IncrementUsedCount(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("IncrementUsedCount() error = %v", err)
+	}
+
+	if len(*statements) != 1 {
+		t.Fatalf("statements = %v, want a single UPDATE", *statements)
+	}
+	for _, want := range []string{
+		`"used_count"=used_count + 1`,
+		`WHERE (id = 7 AND deleted_on IS NULL AND (usage_limit IS NULL OR used_count < usage_limit))`,
+	} {
+		if !strings.Contains((*statements)[0], want) {
+			t.Errorf("statement = %s, want it to contain %s", (*statements)[0], want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package voucher
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers voucher-related routes to the provided router group.
+// Every voucher route requires the voucher:write permission, as listings expose the voucher codes.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	vouchers := router.Group("/vouchers", authz.Require(rbac.VoucherWrite))
+
+	vouchers.Post("/", handler.CreateVoucher)
+	vouchers.Get("/", handler.GetVouchers)
+	vouchers.Get("/:id", handler.GetVoucher)
+	vouchers.Patch("/:id", handler.UpdateVoucher)
+	vouchers.Delete("/:id", handler.DeleteVoucher)
+	vouchers.Get("/:id/redemptions", handler.GetVoucherRedemptions)
+}
@@ -0,0 +1,369 @@
+package voucher
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/dberror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("voucher.service")
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+}
+
+// NewService initializes a new instance of voucher service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper) *service {
+	return &service{uow: uow, mapper: mapper}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.VoucherService = (*service)(nil)
+
+// CreateVoucher handles the creation of a new voucher with its product restrictions.
+func (s *service) CreateVoucher(ctx context.Context, req payload.VoucherCreateRequest) (*payload.VoucherBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CreateVoucher")
+	defer span.End()
+
+	discountValue, err := parsePositive("discount_value", req.DiscountValue)
+	if err != nil {
+		return nil, err
+	}
+	if req.DiscountType == model.VoucherDiscountPercentage && discountValue.GreaterThan(hundred) {
+		return nil, ErrInvalidValue("discount_value", nil)
+	}
+
+	var maxDiscount *decimal.Decimal
+	if req.MaxDiscount != nil {
+		value, err := parsePositive("max_discount", *req.MaxDiscount)
+		if err != nil {
+			return nil, err
+		}
+		maxDiscount = &value
+	}
+
+	minOrderAmount := decimal.Zero
+	if req.MinOrderAmount != nil {
+		minOrderAmount, err = parseNonNegative("min_order_amount", *req.MinOrderAmount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var created *model.Voucher
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		var err error
+		created, err = uow.VoucherRepository().Save(ctx, &model.Voucher{
+			Code:           NormalizeCode(req.Code),
+			Name:           req.Name,
+			Description:    req.Description,
+			DiscountType:   req.DiscountType,
+			DiscountValue:  discountValue,
+			MaxDiscount:    maxDiscount,
+			MinOrderAmount: minOrderAmount,
+			ValidFrom:      req.ValidFrom,
+			ValidUntil:     req.ValidUntil,
+			UsageLimit:     req.UsageLimit,
+			PerUserLimit:   req.PerUserLimit,
+			IsActive:       req.IsActive,
+			CreatedBy:      actor.FromContext(ctx).JSON(),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uow.VoucherRepository().ReplaceProducts(ctx, created.ID, req.ProductIDs)
+		if err != nil {
+			return err
+		}
+
+		created, err = uow.VoucherRepository().FindByID(ctx, created.ID)
+		return err
+	})
+	if err != nil {
+		// check db-specific error
+		if pgErr := dberror.GetError(err); pgErr != nil {
+			switch pgErr.Code {
+			case dberror.UniqueViolation:
+				msg, details := dberror.ParseUniqueConstraintError(pgErr)
+				return nil, apperror.New(apperror.DuplicateEntry, msg, err, details)
+			case dberror.ForeignKeyViolation:
+				return nil, ErrProductsNotFound(err)
+			}
+		}
+
+		return nil, err
+	}
+
+	return s.toResponse(created)
+}
+
+// GetAllVouchers retrieves a list of vouchers with support for pagination and filtering.
+func (s *service) GetAllVouchers(ctx context.Context, req payload.VoucherGetAllRequest) ([]payload.VoucherBaseResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetAllVouchers")
+	defer span.End()
+
+	if req.VoucherFilter == nil {
+		req.VoucherFilter = &model.VoucherFilter{}
+	}
+
+	var count int64
+	var vouchers []model.Voucher
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.VoucherRepository().Count(groupCtx, req.VoucherFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		vouchers, err = s.uow.VoucherRepository().FindAll(groupCtx, req.Page, req.Size, req.VoucherFilter)
+		return err
+	})
+
+	err := group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := make([]payload.VoucherBaseResponse, 0, len(vouchers))
+	for i := range vouchers {
+		item, err := s.toResponse(&vouchers[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		resp = append(resp, *item)
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// GetVoucherByID retrieves a specific voucher by its unique identifier.
+func (s *service) GetVoucherByID(ctx context.Context, id uint) (*payload.VoucherBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetVoucherByID")
+	defer span.End()
+
+	voucher, err := s.uow.VoucherRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrVoucherNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toResponse(voucher)
+}
+
+// UpdateVoucher modifies an existing voucher's information.
+// The usage limit cannot be set below the number of times the voucher has already been used.
+func (s *service) UpdateVoucher(ctx context.Context, id uint, req payload.VoucherUpdateRequest) (*payload.VoucherBaseResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "UpdateVoucher")
+	defer span.End()
+
+	var updated *model.Voucher
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		voucher, err := uow.VoucherRepository().FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrVoucherNotFound(err)
+			}
+
+			return err
+		}
+
+		if req.Name != nil {
+			voucher.Name = *req.Name
+		}
+		if req.Description != nil {
+			voucher.Description = req.Description
+		}
+		if req.MinOrderAmount != nil {
+			voucher.MinOrderAmount, err = parseNonNegative("min_order_amount", *req.MinOrderAmount)
+			if err != nil {
+				return err
+			}
+		}
+		if req.ValidFrom != nil {
+			voucher.ValidFrom = *req.ValidFrom
+		}
+		if req.ValidUntil != nil {
+			voucher.ValidUntil = *req.ValidUntil
+		}
+		if !voucher.ValidUntil.After(voucher.ValidFrom) {
+			return ErrInvalidValue("valid_until", nil)
+		}
+		if req.UsageLimit != nil {
+			if *req.UsageLimit < voucher.UsedCount {
+				return ErrUsageLimitBelowUsed(voucher.UsedCount)
+			}
+			voucher.UsageLimit = req.UsageLimit
+		}
+		if req.PerUserLimit != nil {
+			voucher.PerUserLimit = req.PerUserLimit
+		}
+		if req.IsActive != nil {
+			voucher.IsActive = req.IsActive
+		}
+
+		now := time.Now()
+		voucher.ModifiedOn = &now
+		voucher.ModifiedBy = actor.FromContext(ctx).JSON()
+		voucher.Products = nil // restrictions are managed through ReplaceProducts
+
+		_, err = uow.VoucherRepository().Update(ctx, voucher)
+		if err != nil {
+			return err
+		}
+
+		if req.ProductIDs != nil {
+			err = uow.VoucherRepository().ReplaceProducts(ctx, voucher.ID, *req.ProductIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		updated, err = uow.VoucherRepository().FindByID(ctx, voucher.ID)
+		return err
+	})
+	if err != nil {
+		if dberror.GetSQLState(err) == dberror.ForeignKeyViolation {
+			return nil, ErrProductsNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	return s.toResponse(updated)
+}
+
+// DeleteVoucher removes a voucher record from the database. Existing redemptions are kept.
+func (s *service) DeleteVoucher(ctx context.Context, id uint) error {
+	ctx, span := serviceTracer.Start(ctx, "DeleteVoucher")
+	defer span.End()
+
+	_, err := s.uow.VoucherRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrVoucherNotFound(err)
+		}
+
+		return err
+	}
+
+	return s.uow.VoucherRepository().Delete(ctx, id)
+}
+
+// GetVoucherRedemptions retrieves the redemptions of a voucher with support for pagination.
+func (s *service) GetVoucherRedemptions(ctx context.Context, id uint, req payload.VoucherRedemptionGetAllRequest) ([]payload.VoucherRedemptionResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetVoucherRedemptions")
+	defer span.End()
+
+	_, err := s.uow.VoucherRepository().FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrVoucherNotFound(err)
+		}
+
+		return nil, nil, err
+	}
+
+	if req.VoucherRedemptionFilter == nil {
+		req.VoucherRedemptionFilter = &model.VoucherRedemptionFilter{}
+	}
+	req.VoucherID = &id
+
+	var count int64
+	var redemptions []model.VoucherRedemption
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.VoucherRedemptionRepository().Count(groupCtx, req.VoucherRedemptionFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		redemptions, err = s.uow.VoucherRedemptionRepository().FindAll(groupCtx, req.Page, req.Size, req.VoucherRedemptionFilter)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp []payload.VoucherRedemptionResponse
+	err = s.mapper.ToResponse(redemptions, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// toResponse maps a voucher and its product restrictions to the response DTO.
+func (s *service) toResponse(voucher *model.Voucher) (*payload.VoucherBaseResponse, error) {
+	var resp payload.VoucherBaseResponse
+	err := s.mapper.ToResponse(voucher, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if voucher.MaxDiscount != nil {
+		maxDiscount := voucher.MaxDiscount.StringFixed(2)
+		resp.MaxDiscount = &maxDiscount
+	}
+
+	resp.ProductIDs = make([]uint, 0, len(voucher.Products))
+	for _, p := range voucher.Products {
+		resp.ProductIDs = append(resp.ProductIDs, p.ProductID)
+	}
+
+	return &resp, nil
+}
+
+// parsePositive parses a decimal field that must be greater than zero.
+func parsePositive(field, value string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, ErrInvalidValue(field, err)
+	}
+	if !d.IsPositive() {
+		return decimal.Zero, ErrInvalidValue(field, nil)
+	}
+	return d, nil
+}
+
+// parseNonNegative parses a decimal field that must not be negative.
+func parseNonNegative(field, value string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, ErrInvalidValue(field, err)
+	}
+	if d.IsNegative() {
+		return decimal.Zero, ErrInvalidValue(field, nil)
+	}
+	return d, nil
+}
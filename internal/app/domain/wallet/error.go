@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// ==========================================================
+// Wallet Error Constructors
+// ==========================================================
+
+// ErrWalletHoldNotFound creates a new error for missing wallet hold records.
+func ErrWalletHoldNotFound(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.NotFound,
+		"wallet hold not found",
+		err,
+		nil,
+	)
+}
+
+// ErrInvalidAmount creates a new error for wallet amounts that are not a positive number.
+func ErrInvalidAmount(err error) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		err,
+		map[string]any{"amount": apperror.InvalidValue},
+	)
+}
+
+// ErrInvalidHoldReference creates a new error for holds referencing a booking or payment
+// that does not exist or does not belong to the wallet's owner.
+func ErrInvalidHoldReference(field string) *apperror.AppError {
+	return apperror.New(
+		apperror.Validation,
+		"Your request is invalid. Please check the details.",
+		nil,
+		map[string]any{field: apperror.InvalidValue},
+	)
+}
+
+// ErrInsufficientFunds creates a new error for debits and holds exceeding the available wallet balance.
+func ErrInsufficientFunds(available string) *apperror.AppError {
+	return apperror.New(
+		apperror.InsufficientFunds,
+		"wallet balance is insufficient, available balance is "+available,
+		nil,
+		map[string]any{"available_balance": available},
+	)
+}
+
+// ErrHoldNotActive creates a new error for releasing a hold that was already released.
+func ErrHoldNotActive(status model.WalletHoldStatus) *apperror.AppError {
+	return apperror.New(
+		apperror.StateConflict,
+		"wallet hold is not active",
+		nil,
+		map[string]any{"status": status},
+	)
+}
+
+// ErrWalletForbidden creates a new error for accessing the wallet of another user.
+func ErrWalletForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"customers can only access their own wallet",
+		nil,
+		nil,
+	)
+}
+
+// ErrHoldReleaseForbidden creates a new error for releasing a hold placed by someone else.
+func ErrHoldReleaseForbidden() *apperror.AppError {
+	return apperror.New(
+		apperror.Unauthorized,
+		"wallet holds can only be released by staff or whoever placed them",
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Posting describes money moving in or out of a wallet and what it relates to.
+type Posting struct {
+	Type        model.WalletTransactionType
+	Amount      decimal.Decimal
+	BookingID   *uint
+	PaymentID   *uint
+	RefundID    *uint
+	Reference   *string
+	Description *string
+}
+
+// counterAccounts maps each transaction type to the ledger account on the other side of the wallet.
+var counterAccounts = map[model.WalletTransactionType]string{
+	model.WalletTransactionTopUp:    model.LedgerAccountCash,
+	model.WalletTransactionRefund:   model.LedgerAccountRefundPayable,
+	model.WalletTransactionCashback: model.LedgerAccountCashbackExpense,
+	model.WalletTransactionPayment:  model.LedgerAccountBookingReceivable,
+
+	model.WalletTransactionReferralReward: model.LedgerAccountReferralExpense,
+}
+
+// Credit adds money to the wallet of a user, opening the wallet on first use.
+// The wallet row is locked for the rest of the transaction, so postings on the same wallet
+// are applied one at a time. It must be called within a transaction.
+func Credit(ctx context.Context, uow contract.UnitOfWork, userID uint, p Posting) (*model.WalletTransaction, error) {
+	w, err := lock(ctx, uow, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Balance = w.Balance.Add(p.Amount)
+	return post(ctx, uow, w, p, model.LedgerCredit)
+}
+
+// Debit takes money from the wallet of a user. It fails with InsufficientFunds when the amount
+// exceeds the available balance, so a wallet can never be overdrawn or spend held funds.
+// The wallet row is locked for the rest of the transaction, so concurrent debits on the same
+// wallet are serialized. It must be called within a transaction.
+func Debit(ctx context.Context, uow contract.UnitOfWork, userID uint, p Posting) (*model.WalletTransaction, error) {
+	w, err := uow.WalletRepository().FindByUserIDForUpdate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInsufficientFunds(decimal.Zero.StringFixed(2))
+		}
+
+		return nil, err
+	}
+
+	if p.Amount.GreaterThan(w.AvailableBalance()) {
+		return nil, ErrInsufficientFunds(w.AvailableBalance().StringFixed(2))
+	}
+
+	w.Balance = w.Balance.Sub(p.Amount)
+	return post(ctx, uow, w, p, model.LedgerDebit)
+}
+
+// lock opens the wallet of a user if needed and locks it until the transaction ends.
+func lock(ctx context.Context, uow contract.UnitOfWork, userID uint) (*model.Wallet, error) {
+	err := uow.WalletRepository().Open(ctx, &model.Wallet{
+		UserID:    userID,
+		CreatedBy: actor.FromContext(ctx).JSON(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uow.WalletRepository().FindByUserIDForUpdate(ctx, userID)
+}
+
+// post saves the new wallet balance and records the transaction with its two balanced ledger entries.
+// direction is the side of the wallet account: a credit raises the balance and a debit lowers it.
+func post(ctx context.Context, uow contract.UnitOfWork, w *model.Wallet, p Posting, direction model.LedgerDirection) (*model.WalletTransaction, error) {
+	now := time.Now()
+	by := actor.FromContext(ctx).JSON()
+
+	w.ModifiedOn = &now
+	w.ModifiedBy = by
+
+	_, err := uow.WalletRepository().Update(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	counter := model.LedgerCredit
+	if direction == model.LedgerCredit {
+		counter = model.LedgerDebit
+	}
+
+	return uow.WalletTransactionRepository().Save(ctx, &model.WalletTransaction{
+		WalletID:     w.ID,
+		Type:         p.Type,
+		Direction:    direction,
+		Amount:       p.Amount,
+		BalanceAfter: w.Balance,
+		BookingID:    p.BookingID,
+		PaymentID:    p.PaymentID,
+		RefundID:     p.RefundID,
+		Reference:    p.Reference,
+		Description:  p.Description,
+		CreatedBy:    by,
+		Entries: []model.WalletEntry{
+			{Account: model.LedgerAccountWallet, WalletID: &w.ID, Direction: direction, Amount: p.Amount, CreatedBy: by},
+			{Account: counterAccounts[p.Type], Direction: counter, Amount: p.Amount, CreatedBy: by},
+		},
+	})
+}
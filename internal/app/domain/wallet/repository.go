@@ -0,0 +1,108 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var repositoryTracer trace.Tracer = otel.Tracer("wallet.repository")
+
+// Repository implements the contract.WalletRepository interface.
+// It embeds a generic GORM repository to handle basic CRUD operations.
+type Repository struct {
+	*repository.GORM[model.Wallet, model.WalletFilter]
+	db *gorm.DB
+}
+
+// NewRepository creates a new wallet repository instance.
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		GORM: repository.NewGORM[model.Wallet, model.WalletFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.WalletRepository = (*Repository)(nil)
+
+// FindByUserID retrieves the active wallet of a user.
+func (r *Repository) FindByUserID(ctx context.Context, userID uint) (*model.Wallet, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByUserID")
+	defer span.End()
+
+	var data model.Wallet
+	err := r.db.WithContext(ctx).
+		Where("deleted_on IS NULL AND user_id = ?", userID).
+		First(&data).Error
+	return &data, err
+}
+
+// FindByUserIDForUpdate retrieves the active wallet of a user and locks the row until the transaction ends.
+// It must be called within a transaction.
+func (r *Repository) FindByUserIDForUpdate(ctx context.Context, userID uint) (*model.Wallet, error) {
+	ctx, span := repositoryTracer.Start(ctx, "FindByUserIDForUpdate")
+	defer span.End()
+
+	var data model.Wallet
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_on IS NULL AND user_id = ?", userID).
+		First(&data).Error
+	return &data, err
+}
+
+// Open persists a new empty wallet unless its user already has one.
+// Concurrent calls are safe: the unique index on active wallets turns the losing insert into a no-op.
+func (r *Repository) Open(ctx context.Context, wallet *model.Wallet) error {
+	ctx, span := repositoryTracer.Start(ctx, "Open")
+	defer span.End()
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "user_id"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_on IS NULL"}}},
+			DoNothing:   true,
+		}).
+		Create(wallet).Error
+}
+
+// TransactionRepository implements the contract.WalletTransactionRepository interface.
+type TransactionRepository struct {
+	*repository.GORM[model.WalletTransaction, model.WalletTransactionFilter]
+	db *gorm.DB
+}
+
+// NewTransactionRepository creates a new wallet transaction repository instance.
+func NewTransactionRepository(db *gorm.DB) *TransactionRepository {
+	return &TransactionRepository{
+		GORM: repository.NewGORM[model.WalletTransaction, model.WalletTransactionFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.WalletTransactionRepository = (*TransactionRepository)(nil)
+
+// HoldRepository implements the contract.WalletHoldRepository interface.
+type HoldRepository struct {
+	*repository.GORM[model.WalletHold, model.WalletHoldFilter]
+	db *gorm.DB
+}
+
+// NewHoldRepository creates a new wallet hold repository instance.
+func NewHoldRepository(db *gorm.DB) *HoldRepository {
+	return &HoldRepository{
+		GORM: repository.NewGORM[model.WalletHold, model.WalletHoldFilter](db),
+		db:   db,
+	}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.WalletHoldRepository = (*HoldRepository)(nil)
@@ -0,0 +1,20 @@
+package wallet
+
+import (
+	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRoute registers wallet-related routes to the provided router group.
+// Crediting a wallet requires the wallet:credit permission.
+func NewRoute(router fiber.Router, handler *Handler, authz *middleware.Authorizer) {
+	wallets := router.Group("/users/:id/wallet")
+	wallets.Get("/", handler.GetUserWallet)
+	wallets.Get("/transactions", handler.GetUserWalletTransactions)
+	wallets.Post("/credits", authz.Require(rbac.WalletCredit), handler.CreditUserWallet)
+	wallets.Get("/holds", handler.GetUserWalletHolds)
+	wallets.Post("/holds", handler.PlaceUserWalletHold)
+
+	router.Post("/wallet-holds/:id/release", handler.ReleaseWalletHold)
+}
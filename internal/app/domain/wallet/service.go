@@ -0,0 +1,461 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/user"
+	"github.com/aburizalpurnama/travel/internal/app/model"
+	"github.com/aburizalpurnama/travel/internal/app/payload"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+var serviceTracer trace.Tracer = otel.Tracer("wallet.service")
+
+type service struct {
+	uow    contract.UnitOfWork
+	mapper contract.Mapper
+}
+
+// NewService initializes a new instance of wallet service.
+func NewService(uow contract.UnitOfWork, mapper contract.Mapper) *service {
+	return &service{uow: uow, mapper: mapper}
+}
+
+// Ensures implementaton satisfies the contract at compile-time.
+var _ contract.WalletService = (*service)(nil)
+
+// GetUserWallet retrieves the wallet of a user. Users without a wallet yet get an empty one.
+func (s *service) GetUserWallet(ctx context.Context, userID uint) (*payload.WalletResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetUserWallet")
+	defer span.End()
+
+	err := authorize(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := s.findWallet(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWalletResponse(w), nil
+}
+
+// CreditUserWallet adds a top-up or cashback to the wallet of a user, opening the wallet on first use.
+func (s *service) CreditUserWallet(ctx context.Context, userID uint, req payload.WalletCreditRequest) (*payload.WalletTransactionResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "CreditUserWallet")
+	defer span.End()
+
+	amount, err := parseAmount(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *model.WalletTransaction
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		_, err := uow.UserRepository().FindByID(ctx, userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return user.ErrUserNotFound(err)
+			}
+
+			return err
+		}
+
+		created, err = Credit(ctx, uow, userID, Posting{
+			Type:        model.WalletTransactionType(req.Type),
+			Amount:      amount,
+			BookingID:   req.BookingID,
+			Reference:   req.Reference,
+			Description: req.Description,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp payload.WalletTransactionResponse
+	err = s.mapper.ToResponse(created, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetUserWalletTransactions retrieves the transactions of a user's wallet with pagination and filtering.
+func (s *service) GetUserWalletTransactions(ctx context.Context, userID uint, req payload.WalletTransactionGetAllRequest) ([]payload.WalletTransactionResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetUserWalletTransactions")
+	defer span.End()
+
+	err := authorize(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w, err := s.findWallet(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.WalletTransactionFilter == nil {
+		req.WalletTransactionFilter = &model.WalletTransactionFilter{}
+	}
+	req.WalletID = &w.ID
+
+	var count int64
+	var transactions []model.WalletTransaction
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.WalletTransactionRepository().Count(groupCtx, req.WalletTransactionFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		transactions, err = s.uow.WalletTransactionRepository().FindAll(groupCtx, req.Page, req.Size, req.WalletTransactionFilter)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := []payload.WalletTransactionResponse{}
+	err = s.mapper.ToResponse(transactions, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// PlaceUserWalletHold freezes part of the available balance of a user's wallet for one of the user's bookings.
+// Held funds stay in the balance but cannot be spent until the hold is released.
+func (s *service) PlaceUserWalletHold(ctx context.Context, userID uint, req payload.WalletHoldCreateRequest) (*payload.WalletHoldResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "PlaceUserWalletHold")
+	defer span.End()
+
+	err := authorize(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseAmount(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *model.WalletHold
+	err = s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		w, err := uow.WalletRepository().FindByUserIDForUpdate(ctx, userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInsufficientFunds(decimal.Zero.StringFixed(2))
+			}
+
+			return err
+		}
+
+		if amount.GreaterThan(w.AvailableBalance()) {
+			return ErrInsufficientFunds(w.AvailableBalance().StringFixed(2))
+		}
+
+		err = checkHoldReference(ctx, uow, userID, req)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		by := actor.FromContext(ctx).JSON()
+
+		w.HeldAmount = w.HeldAmount.Add(amount)
+		w.ModifiedOn = &now
+		w.ModifiedBy = by
+
+		_, err = uow.WalletRepository().Update(ctx, w)
+		if err != nil {
+			return err
+		}
+
+		created, err = uow.WalletHoldRepository().Save(ctx, &model.WalletHold{
+			WalletID:  w.ID,
+			BookingID: &req.BookingID,
+			PaymentID: req.PaymentID,
+			Amount:    amount,
+			Status:    model.WalletHoldStatusActive,
+			Reason:    req.Reason,
+			CreatedBy: by,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toHoldResponse(created)
+}
+
+// GetUserWalletHolds retrieves the holds of a user's wallet with pagination and filtering.
+func (s *service) GetUserWalletHolds(ctx context.Context, userID uint, req payload.WalletHoldGetAllRequest) ([]payload.WalletHoldResponse, *response.Pagination, error) {
+	ctx, span := serviceTracer.Start(ctx, "GetUserWalletHolds")
+	defer span.End()
+
+	err := authorize(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w, err := s.findWallet(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.WalletHoldFilter == nil {
+		req.WalletHoldFilter = &model.WalletHoldFilter{}
+	}
+	req.WalletID = &w.ID
+
+	var count int64
+	var holds []model.WalletHold
+
+	// Use errgroup for concurrent data fetching (count and data)
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		var err error
+		count, err = s.uow.WalletHoldRepository().Count(groupCtx, req.WalletHoldFilter)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		holds, err = s.uow.WalletHoldRepository().FindAll(groupCtx, req.Page, req.Size, req.WalletHoldFilter)
+		return err
+	})
+
+	err = group.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := []payload.WalletHoldResponse{}
+	err = s.mapper.ToResponse(holds, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, response.NewPagination(req.Page, req.Size, &count), nil
+}
+
+// ReleaseWalletHold releases an active hold, making its amount available again.
+// The wallet is locked before the hold, matching the lock order of debits and new holds.
+// Only staff can release any hold; others only the holds they placed.
+func (s *service) ReleaseWalletHold(ctx context.Context, id uint) (*payload.WalletHoldResponse, error) {
+	ctx, span := serviceTracer.Start(ctx, "ReleaseWalletHold")
+	defer span.End()
+
+	var updated *model.WalletHold
+	err := s.uow.RunInTransaction(ctx, func(ctx context.Context, uow contract.UnitOfWork) error {
+		hold, err := uow.WalletHoldRepository().FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrWalletHoldNotFound(err)
+			}
+
+			return err
+		}
+
+		w, err := uow.WalletRepository().FindByIDForUpdate(ctx, hold.WalletID)
+		if err != nil {
+			return err
+		}
+
+		err = authorize(ctx, w.UserID)
+		if err != nil {
+			return err
+		}
+
+		hold, err = uow.WalletHoldRepository().FindByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = authorizeRelease(ctx, hold)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != model.WalletHoldStatusActive {
+			return ErrHoldNotActive(hold.Status)
+		}
+
+		now := time.Now()
+		by := actor.FromContext(ctx).JSON()
+
+		w.HeldAmount = w.HeldAmount.Sub(hold.Amount)
+		w.ModifiedOn = &now
+		w.ModifiedBy = by
+
+		_, err = uow.WalletRepository().Update(ctx, w)
+		if err != nil {
+			return err
+		}
+
+		hold.Status = model.WalletHoldStatusReleased
+		hold.ReleasedBy = by
+		hold.ReleasedOn = &now
+		hold.ModifiedOn = &now
+		hold.ModifiedBy = by
+
+		updated, err = uow.WalletHoldRepository().Update(ctx, hold)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toHoldResponse(updated)
+}
+
+// authorize checks that the actor may access the wallet of the given user.
+// A customer only accesses their own; muthawif and partners access none; staff and the SYSTEM actor access all.
+func authorize(ctx context.Context, userID uint) error {
+	a := actor.FromContext(ctx)
+	switch a.Role {
+	case model.UserRoleCustomer:
+		if a.ID != userID {
+			return ErrWalletForbidden()
+		}
+	case model.UserRoleMuthawif, model.AdminRoleAgent, model.AdminRoleFinInst:
+		return ErrWalletForbidden()
+	}
+
+	return nil
+}
+
+// authorizeRelease checks that the actor may release the given hold.
+// Staff and the SYSTEM actor release any hold; others only the holds they placed.
+func authorizeRelease(ctx context.Context, hold *model.WalletHold) error {
+	a := actor.FromContext(ctx)
+	if a.Role == model.AdminRoleAdmin || a.Role == "" {
+		return nil
+	}
+
+	if actor.Parse(hold.CreatedBy).UID != a.UID {
+		return ErrHoldReleaseForbidden()
+	}
+
+	return nil
+}
+
+// checkHoldReference checks that a hold is placed for a booking of the wallet's owner and,
+// when it names a payment, for a payment of that booking.
+func checkHoldReference(ctx context.Context, uow contract.UnitOfWork, userID uint, req payload.WalletHoldCreateRequest) error {
+	b, err := uow.BookingRepository().FindByID(ctx, req.BookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidHoldReference("booking_id")
+		}
+
+		return err
+	}
+
+	if b.UserID != userID {
+		return ErrInvalidHoldReference("booking_id")
+	}
+
+	if req.PaymentID == nil {
+		return nil
+	}
+
+	p, err := uow.PaymentRepository().FindByID(ctx, *req.PaymentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidHoldReference("payment_id")
+		}
+
+		return err
+	}
+
+	if p.BookingID != b.ID {
+		return ErrInvalidHoldReference("payment_id")
+	}
+
+	return nil
+}
+
+// findWallet retrieves the wallet of an existing user. Users without a wallet yet get an empty,
+// unsaved one so reads never create rows.
+func (s *service) findWallet(ctx context.Context, userID uint) (*model.Wallet, error) {
+	w, err := s.uow.WalletRepository().FindByUserID(ctx, userID)
+	if err == nil {
+		return w, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	_, err = s.uow.UserRepository().FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, user.ErrUserNotFound(err)
+		}
+
+		return nil, err
+	}
+
+	return &model.Wallet{UserID: userID}, nil
+}
+
+// toHoldResponse maps a wallet hold to the response DTO.
+func (s *service) toHoldResponse(hold *model.WalletHold) (*payload.WalletHoldResponse, error) {
+	var resp payload.WalletHoldResponse
+	err := s.mapper.ToResponse(hold, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// toWalletResponse maps a wallet and its derived available balance to the response DTO.
+func toWalletResponse(w *model.Wallet) *payload.WalletResponse {
+	return &payload.WalletResponse{
+		ID:               w.ID,
+		UID:              w.UID,
+		UserID:           w.UserID,
+		Balance:          w.Balance.StringFixed(2),
+		HeldAmount:       w.HeldAmount.StringFixed(2),
+		AvailableBalance: w.AvailableBalance().StringFixed(2),
+	}
+}
+
+// parseAmount parses a wallet amount, which must be a positive number.
+func parseAmount(s string) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, ErrInvalidAmount(err)
+	}
+	if !amount.IsPositive() {
+		return decimal.Zero, ErrInvalidAmount(nil)
+	}
+
+	return amount, nil
+}
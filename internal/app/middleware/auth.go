@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/aburizalpurnama/travel/internal/pkg/token"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Authenticate initializes a middleware that requires a valid bearer access token on every request
+// except the public ones, and attaches the authenticated principal to the request context as its actor.
+// Missing or invalid tokens, and tokens of sessions on the denylist, are rejected with Unauthenticated;
+// expired ones with TokenExpired.
+func Authenticate(tokens contract.AccessTokenManager, denylist contract.AccessTokenDenylistRepository, isPublic func(c *fiber.Ctx) bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if isPublic != nil && isPublic(c) {
+			return c.Next()
+		}
+
+		raw, ok := bearerToken(c.Get(fiber.HeaderAuthorization))
+		if !ok {
+			return c.Status(http.StatusUnauthorized).JSON(
+				response.Error(apperror.Unauthenticated, "missing or malformed access token", nil),
+			)
+		}
+
+		principal, err := tokens.Parse(raw)
+		if err != nil {
+			c.Locals("error", err)
+
+			if errors.Is(err, token.ErrExpired) {
+				return c.Status(http.StatusUnauthorized).JSON(
+					response.Error(apperror.TokenExpired, "access token has expired", nil),
+				)
+			}
+
+			return c.Status(http.StatusUnauthorized).JSON(
+				response.Error(apperror.Unauthenticated, "invalid access token", nil),
+			)
+		}
+
+		denied, err := denylist.IsDenied(c.Context(), principal.SessionID)
+		if err != nil {
+			c.Locals("error", err)
+			return c.Status(http.StatusInternalServerError).JSON(
+				response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+			)
+		}
+		if denied {
+			return c.Status(http.StatusUnauthorized).JSON(
+				response.Error(apperror.Unauthenticated, "access token has been revoked", nil),
+			)
+		}
+
+		actor.Attach(c.Context(), principal)
+		c.SetUserContext(actor.NewContext(c.UserContext(), principal))
+
+		return c.Next()
+	}
+}
+
+// PublicRoutes returns a matcher for routes that can be called without an access token.
+// Routes are given as "METHOD /full/path", e.g. "POST /api/v1/auth/login"; trailing slashes are ignored.
+func PublicRoutes(routes ...string) func(c *fiber.Ctx) bool {
+	public := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		public[route] = true
+	}
+
+	return func(c *fiber.Ctx) bool {
+		path := c.Path()
+		if len(path) > 1 {
+			path = strings.TrimSuffix(path, "/")
+		}
+		return public[c.Method()+" "+path]
+	}
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header value.
+func bearerToken(header string) (string, bool) {
+	scheme, raw, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	raw = strings.TrimSpace(raw)
+	return raw, raw != ""
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+	"github.com/aburizalpurnama/travel/internal/pkg/httphelper"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/aburizalpurnama/travel/internal/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Authorizer builds route-level middlewares that check the actor's role against a policy.
+type Authorizer struct {
+	policy *rbac.Policy
+}
+
+// NewAuthorizer creates a new authorizer enforcing the given policy.
+func NewAuthorizer(policy *rbac.Policy) *Authorizer {
+	return &Authorizer{policy: policy}
+}
+
+// Require initializes a middleware that only lets a request through when the roles of its actor
+// are granted every one of the permissions. It must run after Authenticate; requests without
+// an authenticated actor carry no role and are denied. Denials are rejected with Unauthorized.
+func (a *Authorizer) Require(permissions ...rbac.Permission) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		principal := actor.FromContext(c.Context())
+
+		err := a.policy.Authorize(principal.Roles(), permissions...)
+		if err != nil {
+			c.Locals("error", err)
+
+			var appErr *apperror.AppError
+			if errors.As(err, &appErr) {
+				return c.Status(httphelper.MapErrorToHTTPStatus(appErr.Code)).JSON(
+					response.Error(appErr.Code, appErr.Message, appErr.Details),
+				)
+			}
+
+			return c.Status(http.StatusInternalServerError).JSON(
+				response.Error(apperror.Internal, apperror.ERR_INTERNAL_MSG, nil),
+			)
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestAuthorizerRequire(t *testing.T) {
+	authz := NewAuthorizer(rbac.NewPolicy(map[string][]rbac.Permission{
+		"admin":       {rbac.ProductWrite},
+		"super_admin": {rbac.AdminManage},
+	}))
+
+	tests := []struct {
+		name        string
+		principal   *actor.Actor
+		permissions []rbac.Permission
+		want        int
+	}{
+		{"granted", &actor.Actor{ID: 1, Role: "admin"}, []rbac.Permission{rbac.ProductWrite}, http.StatusOK},
+		{"granted through role level", &actor.Actor{ID: 1, Role: "admin", Level: "super_admin"}, []rbac.Permission{rbac.ProductWrite, rbac.AdminManage}, http.StatusOK},
+		{"not granted", &actor.Actor{ID: 2, Role: "customer"}, []rbac.Permission{rbac.ProductWrite}, http.StatusForbidden},
+		{"one missing", &actor.Actor{ID: 1, Role: "admin"}, []rbac.Permission{rbac.ProductWrite, rbac.AdminManage}, http.StatusForbidden},
+		{"unauthenticated", nil, []rbac.Permission{rbac.ProductWrite}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(func(c *fiber.Ctx) error {
+				if tt.principal != nil {
+					actor.Attach(c.Context(), *tt.principal)
+				}
+				return c.Next()
+			})
+			app.Get("/", authz.Require(tt.permissions...), func(c *fiber.Ctx) error {
+				return c.SendStatus(http.StatusOK)
+			})
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Admin roles mirror the "user.admins_role_enum" type.
+const (
+	AdminRoleAgent   = "agent"
+	AdminRoleAdmin   = "admin"
+	AdminRoleFinInst = "fin_inst"
+)
+
+// Admin role levels mirror the "user.admin_role_level_enum" type.
+// Only admins with the 'admin' role have a level; super admins manage the other admin accounts.
+const (
+	AdminRoleLevelAdmin      = "admin"
+	AdminRoleLevelSuperAdmin = "super_admin"
+)
+
+// Admin represents the GORM model for the "user.admins" table.
+// Admins are back-office accounts kept apart from customers: travel agents, staff and
+// financing institution users, told apart by their role.
+type Admin struct {
+	ID                     uint           `gorm:"primaryKey;autoIncrement"`
+	UID                    string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn              *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy              datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn             *time.Time
+	ModifiedBy             datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn              gorm.DeletedAt `gorm:"index"`
+	FullName               string         `gorm:"type:varchar(255);not null"`
+	Email                  string         `gorm:"type:varchar(320);not null"`
+	Phone                  *string        `gorm:"type:varchar(50)"`
+	PasswordHash           *string        `gorm:"type:varchar(255)"`
+	IsActive               *bool          `gorm:"default:true"`
+	Role                   string         `gorm:"type:user.admins_role_enum;not null"`
+	RoleLevel              *string        `gorm:"type:user.admin_role_level_enum"`
+	FinancingInstitutionID *uint          `gorm:"type:int"` // Institution whose financing requests a fin_inst admin decides
+}
+
+// TableName overrides the default table name to include the schema.
+func (Admin) TableName() string {
+	return "user.admins"
+}
+
+// IsSuperAdmin reports whether the admin is an active super admin.
+func (a *Admin) IsSuperAdmin() bool {
+	return a.Role == AdminRoleAdmin &&
+		a.RoleLevel != nil && *a.RoleLevel == AdminRoleLevelSuperAdmin &&
+		(a.IsActive == nil || *a.IsActive)
+}
+
+// AdminFilter defines the available filter criteria for querying admins.
+type AdminFilter struct {
+	Role      *string `query:"role"`
+	RoleLevel *string `query:"role_level"`
+	IsActive  *bool   `query:"is_active"`
+	Search    *string `query:"search" search:"full_name,email,phone"`
+}
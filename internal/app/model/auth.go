@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// RefreshToken represents the GORM model for the "user.refresh_tokens" table.
+// Only the SHA-256 hash of a token is stored. Every token is used once: refreshing marks it used and
+// issues its replacement in the same family, which also identifies the login session.
+type RefreshToken struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	UID           string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn     *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy     datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn    *time.Time
+	ModifiedBy    datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn     gorm.DeletedAt `gorm:"index"`
+	PrincipalID   uint           `gorm:"type:int;not null"`
+	PrincipalRole string         `gorm:"type:varchar(20);not null"`
+	FamilyID      string         `gorm:"type:uuid;not null"`
+	TokenHash     string         `gorm:"type:char(64);not null"`
+	ExpiresOn     time.Time      `gorm:"not null"`
+	UsedOn        *time.Time
+	ReplacedByID  *uint `gorm:"type:int"`
+	RevokedOn     *time.Time
+}
+
+// TableName overrides the default table name to include the schema.
+func (RefreshToken) TableName() string {
+	return "user.refresh_tokens"
+}
+
+// AccessTokenDenial represents the GORM model for the "user.access_token_denylist" table.
+// Access tokens of a denied session are rejected until ExpiresOn, by which time they have all expired.
+type AccessTokenDenial struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt `gorm:"index"`
+	SessionID  string         `gorm:"type:uuid;not null"`
+	ExpiresOn  time.Time      `gorm:"not null"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (AccessTokenDenial) TableName() string {
+	return "user.access_token_denylist"
+}
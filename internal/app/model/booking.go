@@ -0,0 +1,101 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// BookingStatus mirrors the "transaction.bookings_status_enum" type.
+type BookingStatus string
+
+const (
+	BookingStatusBooked    BookingStatus = "booked"
+	BookingStatusConfirmed BookingStatus = "confirmed"
+	BookingStatusOnTrip    BookingStatus = "on-trip"
+	BookingStatusDone      BookingStatus = "done"
+	BookingStatusCanceled  BookingStatus = "canceled"
+	BookingStatusRefunded  BookingStatus = "refunded"
+)
+
+// PaymentStatus mirrors the "transaction.bookings_payment_status_enum" type.
+type PaymentStatus string
+
+const (
+	PaymentStatusUnpaid PaymentStatus = "unpaid"
+	PaymentStatusDP     PaymentStatus = "dp"
+	PaymentStatusPaid   PaymentStatus = "paid"
+)
+
+// Booking represents the GORM model for the "transaction.bookings" table.
+type Booking struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement"`
+	UID            string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn      *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy      datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn     *time.Time
+	ModifiedBy     datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn      gorm.DeletedAt  `gorm:"index"`
+	Code           string          `gorm:"type:varchar(100);not null"`
+	Date           *time.Time      `gorm:"default:CURRENT_TIMESTAMP"`
+	ProductID      *uint           `gorm:"type:int"`
+	ProductName    *string         `gorm:"type:varchar(255)"`
+	UserID         uint            `gorm:"type:int;not null"`
+	UserFullName   string          `gorm:"type:varchar(255);not null"`
+	TotalQty       int             `gorm:"type:int;not null"`
+	TotalAmount    decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+	Status         BookingStatus   `gorm:"type:transaction.bookings_status_enum;default:booked"`
+	PaymentStatus  PaymentStatus   `gorm:"type:transaction.bookings_payment_status_enum;default:unpaid"`
+	TotalPayment   decimal.Decimal `gorm:"type:decimal(18,2);default:0"`
+	MaxPaymentTime *time.Time
+
+	InstallmentRequestStatus *InstallmentRequestStatus `gorm:"type:transaction.bookings_installment_request_status_enum"`
+	DepartureBatchID         *uint                     `gorm:"type:int"`
+	VoucherID                *uint                     `gorm:"type:int"`
+	DiscountAmount           decimal.Decimal           `gorm:"type:decimal(18,2);default:0"`
+	AgentID                  *uint                     `gorm:"type:int"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (Booking) TableName() string {
+	return "transaction.bookings"
+}
+
+// BookingFilter defines the available filter criteria for querying bookings.
+type BookingFilter struct {
+	Status           *string `query:"status"`
+	PaymentStatus    *string `query:"payment_status"`
+	UserID           *uint   `query:"user_id"`
+	ProductID        *uint   `query:"product_id"`
+	DepartureBatchID *uint   `query:"departure_batch_id"`
+	AgentID          *uint   `query:"agent_id"`
+	Search           *string `query:"search" search:"code,product_name,user_full_name"`
+}
+
+// BookingStatusHistory represents the GORM model for the "transaction.booking_status_histories" table.
+// Each record captures a single status transition; CreatedBy and CreatedOn identify the actor and time.
+type BookingStatusHistory struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt `gorm:"index"`
+	BookingID  uint           `gorm:"type:int;not null"`
+	FromStatus *BookingStatus `gorm:"type:transaction.bookings_status_enum"`
+	ToStatus   BookingStatus  `gorm:"type:transaction.bookings_status_enum;not null"`
+	Reason     *string        `gorm:"type:text"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (BookingStatusHistory) TableName() string {
+	return "transaction.booking_status_histories"
+}
+
+// BookingStatusHistoryFilter defines the available filter criteria for querying booking status histories.
+type BookingStatusHistoryFilter struct {
+	BookingID *uint `query:"booking_id"`
+}
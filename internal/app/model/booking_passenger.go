@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// PassengerRelationship mirrors the "transaction.booking_passengers_relationship_enum" type.
+// It describes how a passenger relates to the lead booker.
+type PassengerRelationship string
+
+const (
+	PassengerRelationshipSelf     PassengerRelationship = "self"
+	PassengerRelationshipSpouse   PassengerRelationship = "spouse"
+	PassengerRelationshipChild    PassengerRelationship = "child"
+	PassengerRelationshipParent   PassengerRelationship = "parent"
+	PassengerRelationshipSibling  PassengerRelationship = "sibling"
+	PassengerRelationshipRelative PassengerRelationship = "relative"
+	PassengerRelationshipOther    PassengerRelationship = "other"
+)
+
+// BookingPassenger represents the GORM model for the "transaction.booking_passengers" table.
+// Each record is a traveller (jamaah) on a booking, as written on their passport.
+type BookingPassenger struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement"`
+	UID            string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn      *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy      datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn     *time.Time
+	ModifiedBy     datatypes.JSON        `gorm:"type:jsonb"`
+	DeletedOn      gorm.DeletedAt        `gorm:"index"`
+	BookingID      uint                  `gorm:"type:int;not null"`
+	FullName       string                `gorm:"type:varchar(255);not null"`
+	Gender         string                `gorm:"type:user.customer_gender_enum;not null"`
+	DateOfBirth    time.Time             `gorm:"type:date;not null"`
+	PassportNumber string                `gorm:"type:varchar(50);not null"`
+	PassportExpiry time.Time             `gorm:"type:date;not null"`
+	Relationship   PassengerRelationship `gorm:"type:transaction.booking_passengers_relationship_enum;not null"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (BookingPassenger) TableName() string {
+	return "transaction.booking_passengers"
+}
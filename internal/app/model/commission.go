@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// CommissionRate represents the GORM model for the "core.commission_rates" table.
+// A rate is scoped to an agent, a product, or an agent on a product. The most specific
+// rate matching a booking decides its commission.
+type CommissionRate struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt  `gorm:"index"`
+	AgentID    *uint           `gorm:"type:int"`
+	ProductID  *uint           `gorm:"type:int"`
+	Percent    decimal.Decimal `gorm:"type:decimal(5,2);not null"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (CommissionRate) TableName() string {
+	return "core.commission_rates"
+}
+
+// CommissionRateFilter defines the available filter criteria for querying commission rates.
+type CommissionRateFilter struct {
+	AgentID   *uint `query:"agent_id"`
+	ProductID *uint `query:"product_id"`
+}
+
+// AgentCommission represents the GORM model for the "transaction.agent_commissions" table.
+// It is recorded once per booking, when a booking attributed to an agent is paid in full.
+type AgentCommission struct {
+	ID               uint           `gorm:"primaryKey;autoIncrement"`
+	UID              string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn        *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy        datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn       *time.Time
+	ModifiedBy       datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn        gorm.DeletedAt  `gorm:"index"`
+	AgentID          uint            `gorm:"type:int;not null"`
+	BookingID        uint            `gorm:"type:int;not null"`
+	BookingCode      string          `gorm:"type:varchar(100);not null"`
+	CommissionRateID uint            `gorm:"type:int;not null"`
+	BookingAmount    decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+	Percent          decimal.Decimal `gorm:"type:decimal(5,2);not null"`
+	Amount           decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+	Period           string          `gorm:"type:varchar(7);not null"`
+	EarnedOn         time.Time       `gorm:"not null"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (AgentCommission) TableName() string {
+	return "transaction.agent_commissions"
+}
+
+// AgentCommissionFilter defines the available filter criteria for querying agent commissions.
+type AgentCommissionFilter struct {
+	AgentID *uint   `query:"agent_id"`
+	Period  *string `query:"period"`
+}
+
+// CommissionStatement totals the commissions of an agent earned in one period (YYYY-MM).
+type CommissionStatement struct {
+	Period        string
+	BookingCount  int64
+	BookingAmount decimal.Decimal
+	Amount        decimal.Decimal
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// DepartureBatch represents the GORM model for the "core.departure_batches" table.
+// A batch is a scheduled departure of a product with a fixed number of seats.
+type DepartureBatch struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	UID           string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn     *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy     datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn    *time.Time
+	ModifiedBy    datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn     gorm.DeletedAt `gorm:"index"`
+	ProductID     uint           `gorm:"type:int;not null"`
+	DepartureDate time.Time      `gorm:"type:date;not null"`
+	ReturnDate    time.Time      `gorm:"type:date;not null"`
+	Quota         int            `gorm:"type:int;not null"`
+	SeatsTaken    int            `gorm:"type:int;default:0"`
+	IsActive      *bool          `gorm:"default:true"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (DepartureBatch) TableName() string {
+	return "core.departure_batches"
+}
+
+// SeatsAvailable returns the number of seats that can still be reserved.
+func (b DepartureBatch) SeatsAvailable() int {
+	return b.Quota - b.SeatsTaken
+}
+
+// DepartureBatchFilter defines the available filter criteria for querying departure batches.
+type DepartureBatchFilter struct {
+	ProductID *uint `query:"product_id"`
+	IsActive  *bool `query:"is_active"`
+}
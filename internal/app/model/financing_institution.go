@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// FinancingInstitution represents the GORM model for the "user.financing_institutions" table.
+// Financing requests are routed to an institution and decided by its fin_inst admins.
+type FinancingInstitution struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt `gorm:"index"`
+	Name       string         `gorm:"type:varchar(255);not null"`
+	IsActive   *bool          `gorm:"default:true"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (FinancingInstitution) TableName() string {
+	return "user.financing_institutions"
+}
+
+// FinancingInstitutionFilter defines the available filter criteria for querying financing institutions.
+type FinancingInstitutionFilter struct {
+	IsActive *bool   `query:"is_active"`
+	Search   *string `query:"search" search:"name"`
+}
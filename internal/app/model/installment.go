@@ -0,0 +1,98 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// InstallmentRequestStatus mirrors the "transaction.bookings_installment_request_status_enum" type.
+type InstallmentRequestStatus string
+
+const (
+	InstallmentRequestStatusRequested InstallmentRequestStatus = "requested"
+	InstallmentRequestStatusApproved  InstallmentRequestStatus = "approved"
+	InstallmentRequestStatusRejected  InstallmentRequestStatus = "rejected"
+)
+
+// InstallmentPlan represents the GORM model for the "transaction.installment_plans" table.
+// Plans routed to a financing institution are decided by that institution, which pays out the financed
+// amount to the booking (DisbursementPaymentID) and collects the repayments itself.
+type InstallmentPlan struct {
+	ID                     uint           `gorm:"primaryKey;autoIncrement"`
+	UID                    string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn              *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy              datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn             *time.Time
+	ModifiedBy             datatypes.JSON           `gorm:"type:jsonb"`
+	DeletedOn              gorm.DeletedAt           `gorm:"index"`
+	BookingID              uint                     `gorm:"type:int;not null"`
+	Status                 InstallmentRequestStatus `gorm:"type:transaction.bookings_installment_request_status_enum;default:requested"`
+	Terms                  int                      `gorm:"type:int;not null"`
+	IntervalMonths         int                      `gorm:"type:int;not null;default:1"`
+	FirstDueDate           time.Time                `gorm:"type:date;not null"`
+	Amount                 decimal.Decimal          `gorm:"type:decimal(18,2);not null"`
+	ApprovedBy             datatypes.JSON           `gorm:"type:jsonb"`
+	ApprovedOn             *time.Time
+	RejectedBy             datatypes.JSON `gorm:"type:jsonb"`
+	RejectedOn             *time.Time
+	DecisionNotes          *string `gorm:"type:text"`
+	FinancingInstitutionID *uint   `gorm:"type:int"`
+	DisbursementPaymentID  *uint   `gorm:"type:int"`
+	DisbursedOn            *time.Time
+	Dues                   []InstallmentDue `gorm:"foreignKey:PlanID"`
+}
+
+// IsFinanced reports whether the plan is routed to a financing institution.
+func (p InstallmentPlan) IsFinanced() bool {
+	return p.FinancingInstitutionID != nil
+}
+
+// TableName overrides the default table name to include the schema.
+func (InstallmentPlan) TableName() string {
+	return "transaction.installment_plans"
+}
+
+// InstallmentPlanFilter defines the available filter criteria for querying installment plans.
+type InstallmentPlanFilter struct {
+	BookingID              *uint   `query:"booking_id"`
+	Status                 *string `query:"status"`
+	FinancingInstitutionID *uint   `query:"financing_institution_id"`
+}
+
+// InstallmentDue represents the GORM model for the "transaction.installment_dues" table.
+// A due is settled once PaidAmount reaches Amount.
+type InstallmentDue struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt  `gorm:"index"`
+	PlanID     uint            `gorm:"type:int;not null"`
+	BookingID  uint            `gorm:"type:int;not null"`
+	Sequence   int             `gorm:"type:int;not null"`
+	DueDate    time.Time       `gorm:"type:date;not null"`
+	Amount     decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+	PaidAmount decimal.Decimal `gorm:"type:decimal(18,2);default:0"`
+	PaidOn     *time.Time
+}
+
+// TableName overrides the default table name to include the schema.
+func (InstallmentDue) TableName() string {
+	return "transaction.installment_dues"
+}
+
+// Outstanding returns the amount that remains to be paid for the due.
+func (d InstallmentDue) Outstanding() decimal.Decimal {
+	return d.Amount.Sub(d.PaidAmount)
+}
+
+// InstallmentDueFilter defines the available filter criteria for querying installment dues.
+type InstallmentDueFilter struct {
+	PlanID    *uint `query:"plan_id"`
+	BookingID *uint `query:"booking_id"`
+}
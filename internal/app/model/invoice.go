@@ -0,0 +1,77 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Invoice represents the GORM model for the "transaction.invoices" table.
+// An invoice snapshots the amounts of its booking at issue time. When they change later, the invoice
+// is voided and a replacement referencing it is issued, so a booking has a single current invoice.
+// Amounts are tax-inclusive: TaxAmount is the share of TotalAmount that is tax.
+type Invoice struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement"`
+	UID            string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn      *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy      datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn     *time.Time
+	ModifiedBy     datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn      gorm.DeletedAt  `gorm:"index"`
+	BookingID      uint            `gorm:"type:int;not null"`
+	Number         string          `gorm:"type:varchar(50);not null"`
+	Period         string          `gorm:"type:varchar(7);not null"`
+	Sequence       int             `gorm:"type:int;not null"`
+	IssuedOn       time.Time       `gorm:"not null"`
+	BookingCode    string          `gorm:"type:varchar(100);not null"`
+	CustomerName   string          `gorm:"type:varchar(255);not null"`
+	Subtotal       decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+	DiscountAmount decimal.Decimal `gorm:"type:decimal(18,2);default:0"`
+	TaxPercent     decimal.Decimal `gorm:"type:decimal(5,2);default:0"`
+	TaxAmount      decimal.Decimal `gorm:"type:decimal(18,2);default:0"`
+	TotalAmount    decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+
+	ReplacesInvoiceID *uint `gorm:"type:int"`
+	VoidedOn          *time.Time
+
+	Lines []InvoiceLine `gorm:"foreignKey:InvoiceID"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (Invoice) TableName() string {
+	return "transaction.invoices"
+}
+
+// InvoiceFilter defines the available filter criteria for querying invoices.
+type InvoiceFilter struct {
+	BookingID *uint   `query:"booking_id"`
+	Period    *string `query:"period"`
+	Search    *string `query:"search" search:"number,booking_code,customer_name"`
+}
+
+// InvoiceLine represents the GORM model for the "transaction.invoice_lines" table.
+type InvoiceLine struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement"`
+	UID            string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn      *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy      datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn     *time.Time
+	ModifiedBy     datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn      gorm.DeletedAt  `gorm:"index"`
+	InvoiceID      uint            `gorm:"type:int;not null"`
+	LineNo         int             `gorm:"type:int;not null"`
+	ProductID      *uint           `gorm:"type:int"`
+	Description    string          `gorm:"type:varchar(255);not null"`
+	Quantity       int             `gorm:"type:int;not null"`
+	UnitPrice      decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+	DiscountAmount decimal.Decimal `gorm:"type:decimal(18,2);default:0"`
+	TaxAmount      decimal.Decimal `gorm:"type:decimal(18,2);default:0"`
+	TotalAmount    decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (InvoiceLine) TableName() string {
+	return "transaction.invoice_lines"
+}
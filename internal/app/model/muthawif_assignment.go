@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// MuthawifAssignment represents the GORM model for the "core.muthawif_assignments" table.
+// It assigns a muthawif (tour guide) to a departure batch. The batch dates are copied so
+// the database can reject assignments that overlap for the same guide.
+type MuthawifAssignment struct {
+	ID               uint           `gorm:"primaryKey;autoIncrement"`
+	UID              string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn        *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy        datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn       *time.Time
+	ModifiedBy       datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn        gorm.DeletedAt `gorm:"index"`
+	DepartureBatchID uint           `gorm:"type:int;not null"`
+	MuthawifID       uint           `gorm:"type:int;not null"`
+	DepartureDate    time.Time      `gorm:"type:date;not null"`
+	ReturnDate       time.Time      `gorm:"type:date;not null"`
+	Notes            *string        `gorm:"type:text"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (MuthawifAssignment) TableName() string {
+	return "core.muthawif_assignments"
+}
+
+// MuthawifAssignmentFilter defines the available filter criteria for querying muthawif assignments.
+type MuthawifAssignmentFilter struct {
+	DepartureBatchID *uint `query:"departure_batch_id"`
+	MuthawifID       *uint `query:"muthawif_id"`
+}
+
+// MuthawifTrip is an assignment joined with the departure batch and product it covers.
+type MuthawifTrip struct {
+	MuthawifAssignment `gorm:"embedded"`
+	ProductID          uint
+	ProductName        string
+	Quota              int
+	SeatsTaken         int
+}
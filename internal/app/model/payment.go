@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Supported payment methods.
+const (
+	PaymentMethodBankTransfer   = "bank_transfer"
+	PaymentMethodVirtualAccount = "virtual_account"
+	PaymentMethodCreditCard     = "credit_card"
+	PaymentMethodEWallet        = "e_wallet"
+	PaymentMethodCash           = "cash"
+	PaymentMethodWallet         = "wallet"
+	PaymentMethodFinancing      = "financing"
+
+	// PaymentMethodRescheduleCredit marks the negative entry moving a surplus out of a booking to the
+	// customer's wallet after a reschedule to a cheaper departure.
+	PaymentMethodRescheduleCredit = "reschedule_credit"
+)
+
+// Payment represents the GORM model for the "transaction.payments" table.
+type Payment struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt  `gorm:"index"`
+	BookingID  uint            `gorm:"type:int;not null"`
+	Amount     decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+	Method     string          `gorm:"type:varchar(50);not null"`
+	Reference  *string         `gorm:"type:varchar(255)"`
+	PaidAt     time.Time       `gorm:"not null"`
+	Notes      *string         `gorm:"type:text"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (Payment) TableName() string {
+	return "transaction.payments"
+}
+
+// PaymentFilter defines the available filter criteria for querying payments.
+type PaymentFilter struct {
+	BookingID *uint   `query:"booking_id"`
+	Method    *string `query:"method"`
+	Search    *string `query:"search" search:"reference,notes"`
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ReferralStatus mirrors the "user.referrals_status_enum" type.
+type ReferralStatus string
+
+const (
+	ReferralStatusPending  ReferralStatus = "pending"
+	ReferralStatusRewarded ReferralStatus = "rewarded"
+)
+
+// Referral represents the GORM model for the "user.referrals" table.
+// It links a referred user (referee) to the user whose code they signed up with (referrer).
+// The referral is rewarded once, when the referee's first booking is paid in full.
+type Referral struct {
+	ID                  uint           `gorm:"primaryKey;autoIncrement"`
+	UID                 string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn           *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy           datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn          *time.Time
+	ModifiedBy          datatypes.JSON   `gorm:"type:jsonb"`
+	DeletedOn           gorm.DeletedAt   `gorm:"index"`
+	ReferrerID          uint             `gorm:"type:int;not null"`
+	RefereeID           uint             `gorm:"type:int;not null"`
+	ReferralCode        string           `gorm:"type:varchar(20);not null"`
+	Status              ReferralStatus   `gorm:"type:user.referrals_status_enum;default:pending"`
+	RewardAmount        *decimal.Decimal `gorm:"type:decimal(18,2)"`
+	RewardedBookingID   *uint            `gorm:"type:int"`
+	RewardedOn          *time.Time
+	WalletTransactionID *uint `gorm:"type:int"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (Referral) TableName() string {
+	return "user.referrals"
+}
+
+// ReferralFilter defines the available filter criteria for querying referrals.
+type ReferralFilter struct {
+	ReferrerID *uint   `query:"referrer_id"`
+	RefereeID  *uint   `query:"referee_id"`
+	Status     *string `query:"status"`
+}
+
+// ReferralTreeRow is a referral found while walking down a referrer's tree, with the
+// referee's name and its depth below the root (direct referrals are at depth 1).
+type ReferralTreeRow struct {
+	Referral    `gorm:"embedded"`
+	Depth       int
+	RefereeName string
+}
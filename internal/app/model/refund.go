@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// RefundStatus mirrors the "core.refunds_status_enum" type.
+type RefundStatus string
+
+const (
+	RefundStatusRequested RefundStatus = "requested"
+	RefundStatusApproved  RefundStatus = "approved"
+	RefundStatusRejected  RefundStatus = "rejected"
+	RefundStatusProcessed RefundStatus = "processed"
+	RefundStatusCompleted RefundStatus = "completed"
+)
+
+// RefundDestination mirrors the "core.refunds_destination_enum" type.
+// It tells where the refunded money is sent once the refund completes.
+type RefundDestination string
+
+const (
+	RefundDestinationBank   RefundDestination = "bank"
+	RefundDestinationWallet RefundDestination = "wallet"
+)
+
+// Refund represents the GORM model for the "core.refunds" table.
+type Refund struct {
+	ID              uint           `gorm:"primaryKey;autoIncrement"`
+	UID             string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn       *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy       datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn      *time.Time
+	ModifiedBy      datatypes.JSON    `gorm:"type:jsonb"`
+	DeletedOn       gorm.DeletedAt    `gorm:"index"`
+	BookingID       uint              `gorm:"type:int;not null"`
+	Amount          decimal.Decimal   `gorm:"type:decimal(18,2);not null"`
+	Status          RefundStatus      `gorm:"type:core.refunds_status_enum;default:requested"`
+	Reason          *string           `gorm:"type:text"`
+	RejectionReason *string           `gorm:"type:text"`
+	Reference       *string           `gorm:"type:varchar(255)"`
+	Destination     RefundDestination `gorm:"type:core.refunds_destination_enum;default:bank"`
+	ReviewedBy      datatypes.JSON    `gorm:"type:jsonb"`
+	ReviewedOn      *time.Time
+	ProcessedBy     datatypes.JSON `gorm:"type:jsonb"`
+	ProcessedOn     *time.Time
+	CompletedBy     datatypes.JSON `gorm:"type:jsonb"`
+	CompletedOn     *time.Time
+}
+
+// TableName overrides the default table name to include the schema.
+func (Refund) TableName() string {
+	return "core.refunds"
+}
+
+// RefundFilter defines the available filter criteria for querying refunds.
+type RefundFilter struct {
+	BookingID *uint   `query:"booking_id"`
+	Status    *string `query:"status"`
+	Search    *string `query:"search" search:"reason,reference"`
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// RescheduleStatus mirrors the "core.reschedules_status_enum" type.
+type RescheduleStatus string
+
+const (
+	RescheduleStatusRequested RescheduleStatus = "requested"
+	RescheduleStatusApproved  RescheduleStatus = "approved"
+	RescheduleStatusRejected  RescheduleStatus = "rejected"
+	RescheduleStatusProcessed RescheduleStatus = "processed"
+)
+
+// Reschedule represents the GORM model for the "core.reschedules" table.
+// The From* columns snapshot the booking at request time and the To* columns the quoted target,
+// so the change remains auditable after the booking has been updated.
+// PriceDifference is ToTotalAmount minus FromTotalAmount: positive is an extra charge, negative a credit.
+type Reschedule struct {
+	ID              uint           `gorm:"primaryKey;autoIncrement"`
+	UID             string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn       *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy       datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn      *time.Time
+	ModifiedBy      datatypes.JSON   `gorm:"type:jsonb"`
+	DeletedOn       gorm.DeletedAt   `gorm:"index"`
+	BookingID       uint             `gorm:"type:int;not null"`
+	Status          RescheduleStatus `gorm:"type:core.reschedules_status_enum;default:requested"`
+	FromProductID   *uint            `gorm:"type:int"`
+	FromProductName *string          `gorm:"type:varchar(255)"`
+	FromDate        time.Time        `gorm:"not null"`
+	FromTotalAmount decimal.Decimal  `gorm:"type:decimal(18,2);not null"`
+	ToProductID     uint             `gorm:"type:int;not null"`
+	ToProductName   string           `gorm:"type:varchar(255);not null"`
+	ToDate          time.Time        `gorm:"not null"`
+	ToTotalAmount   decimal.Decimal  `gorm:"type:decimal(18,2);not null"`
+	PriceDifference decimal.Decimal  `gorm:"type:decimal(18,2);not null"`
+	Reason          *string          `gorm:"type:text"`
+	RejectionReason *string          `gorm:"type:text"`
+	ReviewedBy      datatypes.JSON   `gorm:"type:jsonb"`
+	ReviewedOn      *time.Time
+	ProcessedBy     datatypes.JSON `gorm:"type:jsonb"`
+	ProcessedOn     *time.Time
+
+	FromDepartureBatchID *uint `gorm:"type:int"`
+	ToDepartureBatchID   *uint `gorm:"type:int"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (Reschedule) TableName() string {
+	return "core.reschedules"
+}
+
+// RescheduleFilter defines the available filter criteria for querying reschedules.
+type RescheduleFilter struct {
+	BookingID *uint   `query:"booking_id"`
+	Status    *string `query:"status"`
+}
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// User roles mirror the "user.users_role_enum" type.
+const (
+	UserRoleCustomer = "customer"
+	UserRoleMuthawif = "muthawif"
+)
+
+// User represents the GORM model for the "user.users" table.
+type User struct {
+	ID           uint           `gorm:"primaryKey;autoIncrement"`
+	UID          string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn    *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy    datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn   *time.Time
+	ModifiedBy   datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn    gorm.DeletedAt `gorm:"index"`
+	FullName     string         `gorm:"type:varchar(255);not null"`
+	Gender       string         `gorm:"type:user.customer_gender_enum;not null"`
+	Email        *string        `gorm:"type:varchar(320)"`
+	Phone        string         `gorm:"type:varchar(50);not null"`
+	PasswordHash *string        `gorm:"type:varchar(255)"`
+	IsActive     *bool          `gorm:"default:true"`
+	VerifiedBy   datatypes.JSON `gorm:"type:jsonb"`
+	Role         string         `gorm:"type:user.users_role_enum;not null"`
+	ReferralCode *string        `gorm:"type:varchar(20)"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (User) TableName() string {
+	return "user.users"
+}
+
+// EmailVerified reports whether the user's current email address has been verified.
+// Verifying stamps the address into VerifiedBy, so changing the email address makes it unverified again.
+func (u User) EmailVerified() bool {
+	if u.Email == nil || len(u.VerifiedBy) == 0 {
+		return false
+	}
+
+	var v UserVerification
+	if err := json.Unmarshal(u.VerifiedBy, &v); err != nil {
+		return false
+	}
+	return v.Email == *u.Email
+}
+
+// UserVerification is the content of the "verified_by" column: the audit identity of whoever verified
+// the email address, the address itself and when it was verified.
+type UserVerification struct {
+	UserUID    string    `json:"user_uid"`
+	UserName   string    `json:"user_name"`
+	Email      string    `json:"email"`
+	VerifiedOn time.Time `json:"verified_on"`
+}
+
+// EmailVerificationSend represents the GORM model for the "user.email_verification_sends" table.
+// Each row records a verification email sent to a user, used to throttle resends.
+type EmailVerificationSend struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt `gorm:"index"`
+	UserID     uint           `gorm:"type:int;not null"`
+	Email      string         `gorm:"type:varchar(320);not null"`
+	ExpiresOn  time.Time      `gorm:"not null"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (EmailVerificationSend) TableName() string {
+	return "user.email_verification_sends"
+}
+
+// PasswordResetToken represents the GORM model for the "user.password_reset_tokens" table.
+// Only the SHA-256 hash of a token is stored, and each token can reset the password once.
+type PasswordResetToken struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt `gorm:"index"`
+	UserID     uint           `gorm:"type:int;not null"`
+	TokenHash  string         `gorm:"type:char(64);not null"`
+	ExpiresOn  time.Time      `gorm:"not null"`
+	UsedOn     *time.Time
+}
+
+// TableName overrides the default table name to include the schema.
+func (PasswordResetToken) TableName() string {
+	return "user.password_reset_tokens"
+}
+
+// UserFilter defines the available filter criteria for querying users.
+type UserFilter struct {
+	Role     *string `query:"role"`
+	IsActive *bool   `query:"is_active"`
+	Search   *string `query:"search" search:"full_name,email,phone"`
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Supported voucher discount types.
+const (
+	VoucherDiscountPercentage = "percentage"
+	VoucherDiscountFixed      = "fixed"
+)
+
+// Voucher represents the GORM model for the "core.vouchers" table.
+// A nil UsageLimit or PerUserLimit means the voucher is not limited in that respect.
+type Voucher struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement"`
+	UID            string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn      *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy      datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn     *time.Time
+	ModifiedBy     datatypes.JSON   `gorm:"type:jsonb"`
+	DeletedOn      gorm.DeletedAt   `gorm:"index"`
+	Code           string           `gorm:"type:varchar(50);not null"`
+	Name           string           `gorm:"type:varchar(255);not null"`
+	Description    *string          `gorm:"type:text"`
+	DiscountType   string           `gorm:"type:varchar(20);not null"`
+	DiscountValue  decimal.Decimal  `gorm:"type:decimal(18,2);not null"`
+	MaxDiscount    *decimal.Decimal `gorm:"type:decimal(18,2)"`
+	MinOrderAmount decimal.Decimal  `gorm:"type:decimal(18,2);default:0"`
+	ValidFrom      time.Time        `gorm:"not null"`
+	ValidUntil     time.Time        `gorm:"not null"`
+	UsageLimit     *int             `gorm:"type:int"`
+	PerUserLimit   *int             `gorm:"type:int"`
+	UsedCount      int              `gorm:"type:int;default:0"`
+	IsActive       *bool            `gorm:"default:true"`
+	Products       []VoucherProduct `gorm:"foreignKey:VoucherID"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (Voucher) TableName() string {
+	return "core.vouchers"
+}
+
+// VoucherFilter defines the available filter criteria for querying vouchers.
+type VoucherFilter struct {
+	IsActive     *bool   `query:"is_active"`
+	DiscountType *string `query:"discount_type"`
+	Search       *string `query:"search" search:"code,name"`
+}
+
+// VoucherProduct represents the GORM model for the "core.voucher_products" table,
+// restricting a voucher to the listed products.
+type VoucherProduct struct {
+	VoucherID uint       `gorm:"primaryKey"`
+	ProductID uint       `gorm:"primaryKey"`
+	CreatedOn *time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (VoucherProduct) TableName() string {
+	return "core.voucher_products"
+}
+
+// VoucherRedemption represents the GORM model for the "core.voucher_redemptions" table.
+type VoucherRedemption struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement"`
+	UID            string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn      *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy      datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn     *time.Time
+	ModifiedBy     datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn      gorm.DeletedAt  `gorm:"index"`
+	VoucherID      uint            `gorm:"type:int;not null"`
+	BookingID      uint            `gorm:"type:int;not null"`
+	UserID         uint            `gorm:"type:int;not null"`
+	DiscountAmount decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (VoucherRedemption) TableName() string {
+	return "core.voucher_redemptions"
+}
+
+// VoucherRedemptionFilter defines the available filter criteria for querying voucher redemptions.
+type VoucherRedemptionFilter struct {
+	VoucherID *uint `query:"voucher_id"`
+	UserID    *uint `query:"user_id"`
+}
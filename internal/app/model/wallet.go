@@ -0,0 +1,169 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// WalletTransactionType mirrors the "transaction.wallet_transactions_type_enum" type.
+type WalletTransactionType string
+
+const (
+	WalletTransactionTopUp    WalletTransactionType = "top_up"
+	WalletTransactionRefund   WalletTransactionType = "refund"
+	WalletTransactionCashback WalletTransactionType = "cashback"
+	WalletTransactionPayment  WalletTransactionType = "payment"
+
+	WalletTransactionReferralReward WalletTransactionType = "referral_reward"
+)
+
+// LedgerDirection mirrors the "transaction.wallet_entries_direction_enum" type.
+type LedgerDirection string
+
+const (
+	LedgerDebit  LedgerDirection = "debit"
+	LedgerCredit LedgerDirection = "credit"
+)
+
+// Ledger accounts used by wallet entries. The wallet account holds what is owed to customers,
+// so a credit raises a wallet balance and a debit lowers it; the other accounts are the
+// counterparts that keep every transaction balanced.
+const (
+	LedgerAccountWallet            = "wallet"
+	LedgerAccountCash              = "cash"
+	LedgerAccountRefundPayable     = "refund_payable"
+	LedgerAccountCashbackExpense   = "cashback_expense"
+	LedgerAccountBookingReceivable = "booking_receivable"
+	LedgerAccountReferralExpense   = "referral_expense"
+)
+
+// WalletHoldStatus mirrors the "transaction.wallet_holds_status_enum" type.
+type WalletHoldStatus string
+
+const (
+	WalletHoldStatusActive   WalletHoldStatus = "active"
+	WalletHoldStatusReleased WalletHoldStatus = "released"
+)
+
+// Wallet represents the GORM model for the "transaction.wallets" table.
+// Balance is a running total of the wallet's ledger entries; HeldAmount is the part of it
+// that is frozen by active holds and cannot be spent.
+type Wallet struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt  `gorm:"index"`
+	UserID     uint            `gorm:"type:int;not null"`
+	Balance    decimal.Decimal `gorm:"type:decimal(18,2);default:0"`
+	HeldAmount decimal.Decimal `gorm:"type:decimal(18,2);default:0"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (Wallet) TableName() string {
+	return "transaction.wallets"
+}
+
+// AvailableBalance returns the part of the balance that can be spent.
+func (w Wallet) AvailableBalance() decimal.Decimal {
+	return w.Balance.Sub(w.HeldAmount)
+}
+
+// WalletFilter defines the available filter criteria for querying wallets.
+type WalletFilter struct {
+	UserID *uint `query:"user_id"`
+}
+
+// WalletTransaction represents the GORM model for the "transaction.wallet_transactions" table.
+// Each transaction moves money in or out of a single wallet and is backed by balanced ledger entries.
+type WalletTransaction struct {
+	ID           uint           `gorm:"primaryKey;autoIncrement"`
+	UID          string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn    *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy    datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn   *time.Time
+	ModifiedBy   datatypes.JSON        `gorm:"type:jsonb"`
+	DeletedOn    gorm.DeletedAt        `gorm:"index"`
+	WalletID     uint                  `gorm:"type:int;not null"`
+	Type         WalletTransactionType `gorm:"type:transaction.wallet_transactions_type_enum;not null"`
+	Direction    LedgerDirection       `gorm:"type:transaction.wallet_entries_direction_enum;not null"`
+	Amount       decimal.Decimal       `gorm:"type:decimal(18,2);not null"`
+	BalanceAfter decimal.Decimal       `gorm:"type:decimal(18,2);not null"`
+	BookingID    *uint                 `gorm:"type:int"`
+	PaymentID    *uint                 `gorm:"type:int"`
+	RefundID     *uint                 `gorm:"type:int"`
+	Reference    *string               `gorm:"type:varchar(255)"`
+	Description  *string               `gorm:"type:text"`
+
+	Entries []WalletEntry `gorm:"foreignKey:TransactionID"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (WalletTransaction) TableName() string {
+	return "transaction.wallet_transactions"
+}
+
+// WalletTransactionFilter defines the available filter criteria for querying wallet transactions.
+type WalletTransactionFilter struct {
+	WalletID  *uint   `query:"wallet_id"`
+	Type      *string `query:"type"`
+	BookingID *uint   `query:"booking_id"`
+}
+
+// WalletEntry represents the GORM model for the "transaction.wallet_entries" table.
+// It is a single debit or credit line of a wallet transaction.
+type WalletEntry struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	UID           string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn     *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy     datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn    *time.Time
+	ModifiedBy    datatypes.JSON  `gorm:"type:jsonb"`
+	DeletedOn     gorm.DeletedAt  `gorm:"index"`
+	TransactionID uint            `gorm:"type:int;not null"`
+	Account       string          `gorm:"type:varchar(50);not null"`
+	WalletID      *uint           `gorm:"type:int"`
+	Direction     LedgerDirection `gorm:"type:transaction.wallet_entries_direction_enum;not null"`
+	Amount        decimal.Decimal `gorm:"type:decimal(18,2);not null"`
+}
+
+// TableName overrides the default table name to include the schema.
+func (WalletEntry) TableName() string {
+	return "transaction.wallet_entries"
+}
+
+// WalletHold represents the GORM model for the "transaction.wallet_holds" table.
+// An active hold freezes part of a wallet balance for a booking, or one of its payments, until it is released.
+type WalletHold struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	UID        string         `gorm:"type:uuid;default:gen_random_uuid()"`
+	CreatedOn  *time.Time     `gorm:"default:CURRENT_TIMESTAMP"`
+	CreatedBy  datatypes.JSON `gorm:"type:jsonb;not null"`
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON   `gorm:"type:jsonb"`
+	DeletedOn  gorm.DeletedAt   `gorm:"index"`
+	WalletID   uint             `gorm:"type:int;not null"`
+	BookingID  *uint            `gorm:"type:int"`
+	PaymentID  *uint            `gorm:"type:int"`
+	Amount     decimal.Decimal  `gorm:"type:decimal(18,2);not null"`
+	Status     WalletHoldStatus `gorm:"type:transaction.wallet_holds_status_enum;default:active"`
+	Reason     string           `gorm:"type:text;not null"`
+	ReleasedBy datatypes.JSON   `gorm:"type:jsonb"`
+	ReleasedOn *time.Time
+}
+
+// TableName overrides the default table name to include the schema.
+func (WalletHold) TableName() string {
+	return "transaction.wallet_holds"
+}
+
+// WalletHoldFilter defines the available filter criteria for querying wallet holds.
+type WalletHoldFilter struct {
+	WalletID *uint   `query:"wallet_id"`
+	Status   *string `query:"status"`
+}
@@ -0,0 +1,66 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// AdminGetAllRequest defines the query parameters for retrieving a list of admin accounts.
+type AdminGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.AdminFilter
+}
+
+// AdminCreateRequest defines the payload required to create an admin account.
+// A role level can only be given to the 'admin' role, which defaults to the 'admin' level.
+// A financing institution is required for, and only given to, the 'fin_inst' role.
+type AdminCreateRequest struct {
+	FullName               string  `json:"full_name" validate:"required,max=255"`
+	Email                  string  `json:"email" validate:"required,email,max=320"`
+	Phone                  *string `json:"phone,omitempty" validate:"omitempty,max=50"`
+	Password               string  `json:"password" validate:"required,min=8,max=72"`
+	Role                   string  `json:"role" validate:"required,oneof=agent admin fin_inst"`
+	RoleLevel              *string `json:"role_level,omitempty" validate:"omitempty,oneof=admin super_admin"`
+	FinancingInstitutionID *uint   `json:"financing_institution_id,omitempty" validate:"omitempty,gt=0"`
+}
+
+// AdminUpdateRequest defines the payload for updating an admin account, including disabling it
+// and changing its role, role level or financing institution. All fields are optional to allow partial updates.
+type AdminUpdateRequest struct {
+	FullName               *string `json:"full_name,omitempty" validate:"omitempty,max=255"`
+	Phone                  *string `json:"phone,omitempty" validate:"omitempty,max=50"`
+	IsActive               *bool   `json:"is_active,omitempty" validate:"omitempty"`
+	Role                   *string `json:"role,omitempty" validate:"omitempty,oneof=agent admin fin_inst"`
+	RoleLevel              *string `json:"role_level,omitempty" validate:"omitempty,oneof=admin super_admin"`
+	FinancingInstitutionID *uint   `json:"financing_institution_id,omitempty" validate:"omitempty,gt=0"`
+}
+
+// AdminLoginRequest defines the payload required to log in to an admin account.
+type AdminLoginRequest struct {
+	Email    string `json:"email" validate:"required,max=320"`
+	Password string `json:"password" validate:"required,max=72"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// AdminResponse defines the response structure for an admin account.
+// The password hash is never exposed.
+type AdminResponse struct {
+	ID                     uint      `json:"id"`
+	UID                    string    `json:"uid"`
+	FullName               string    `json:"full_name"`
+	Email                  string    `json:"email"`
+	Phone                  *string   `json:"phone,omitempty"`
+	Role                   string    `json:"role"`
+	RoleLevel              *string   `json:"role_level,omitempty"`
+	IsActive               *bool     `json:"is_active"`
+	FinancingInstitutionID *uint     `json:"financing_institution_id,omitempty"`
+	CreatedOn              time.Time `json:"created_on"`
+}
@@ -0,0 +1,107 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// AgentGetAllRequest defines the query parameters for retrieving a list of agents.
+// The role filter is ignored; only agents are listed.
+type AgentGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.AdminFilter
+}
+
+// AgentCreateRequest defines the payload required to create a travel agent account.
+type AgentCreateRequest struct {
+	FullName string  `json:"full_name" validate:"required,max=255"`
+	Email    string  `json:"email" validate:"required,email,max=320"`
+	Phone    *string `json:"phone,omitempty" validate:"omitempty,max=50"`
+}
+
+// AgentUpdateRequest defines the payload for updating an existing agent.
+// All fields are optional to allow partial updates.
+type AgentUpdateRequest struct {
+	FullName *string `json:"full_name,omitempty" validate:"omitempty,max=255"`
+	Phone    *string `json:"phone,omitempty" validate:"omitempty,max=50"`
+	IsActive *bool   `json:"is_active,omitempty" validate:"omitempty"`
+}
+
+// CommissionRateGetAllRequest defines the query parameters for retrieving a list of commission rates.
+type CommissionRateGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.CommissionRateFilter
+}
+
+// CommissionRateCreateRequest defines the payload required to create a commission rate.
+// A rate needs an agent, a product or both; Percent is a number in (0, 100].
+type CommissionRateCreateRequest struct {
+	AgentID   *uint  `json:"agent_id,omitempty"`
+	ProductID *uint  `json:"product_id,omitempty"`
+	Percent   string `json:"percent" validate:"required"`
+}
+
+// CommissionRateUpdateRequest defines the payload for changing the percentage of a commission rate.
+// Commissions already earned keep the percentage applied at the time.
+type CommissionRateUpdateRequest struct {
+	Percent string `json:"percent" validate:"required"`
+}
+
+// AgentCommissionGetAllRequest defines the query parameters for retrieving the commissions of an agent.
+type AgentCommissionGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.AgentCommissionFilter
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// AgentResponse defines the response structure for a travel agent account.
+type AgentResponse struct {
+	ID        uint      `json:"id"`
+	UID       string    `json:"uid"`
+	FullName  string    `json:"full_name"`
+	Email     string    `json:"email"`
+	Phone     *string   `json:"phone,omitempty"`
+	IsActive  *bool     `json:"is_active"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// CommissionRateResponse defines the response structure for a commission rate.
+type CommissionRateResponse struct {
+	ID        uint      `json:"id"`
+	UID       string    `json:"uid"`
+	AgentID   *uint     `json:"agent_id,omitempty"`
+	ProductID *uint     `json:"product_id,omitempty"`
+	Percent   string    `json:"percent"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// AgentCommissionResponse defines the response structure for a commission earned on a booking.
+type AgentCommissionResponse struct {
+	ID               uint      `json:"id"`
+	UID              string    `json:"uid"`
+	AgentID          uint      `json:"agent_id"`
+	BookingID        uint      `json:"booking_id"`
+	BookingCode      string    `json:"booking_code"`
+	CommissionRateID uint      `json:"commission_rate_id"`
+	BookingAmount    string    `json:"booking_amount"`
+	Percent          string    `json:"percent"`
+	Amount           string    `json:"amount"`
+	Period           string    `json:"period"`
+	EarnedOn         time.Time `json:"earned_on"`
+}
+
+// CommissionStatementResponse defines the response structure for the commission totals of an agent in one period.
+type CommissionStatementResponse struct {
+	Period        string `json:"period"`
+	BookingCount  int64  `json:"booking_count"`
+	BookingAmount string `json:"booking_amount"`
+	Amount        string `json:"amount"`
+}
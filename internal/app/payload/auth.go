@@ -0,0 +1,34 @@
+package payload
+
+import "time"
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// LoginRequest defines the payload required to log in with an email address or phone number and a password.
+type LoginRequest struct {
+	Login    string `json:"login" validate:"required,max=320"`
+	Password string `json:"password" validate:"required,max=72"`
+}
+
+// RefreshTokenRequest defines the payload required to exchange a refresh token for a new pair of tokens.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required,max=255"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// TokenResponse defines the response structure for an issued pair of tokens.
+// The access token is sent back in the "Authorization: Bearer <token>" header of subsequent requests;
+// the refresh token can be exchanged once for a new pair before it expires.
+type TokenResponse struct {
+	AccessToken           string    `json:"access_token"`
+	TokenType             string    `json:"token_type"`
+	ExpiresIn             int64     `json:"expires_in"`
+	ExpiresAt             time.Time `json:"expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
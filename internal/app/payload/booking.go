@@ -0,0 +1,88 @@
+package payload
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// BookingGetAllRequest defines the query parameters for retrieving a list of bookings.
+// It combines common pagination/sorting parameters with specific booking filters.
+type BookingGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.BookingFilter
+}
+
+// BookingCreateRequest defines the payload required to create a new booking.
+// Product name, user full name and total amount are derived from the referenced records.
+// When a departure batch is given, seats are reserved on it and the date is taken from its departure date.
+// When a voucher code is given, its discount is deducted from the total amount.
+// When an agent is given, the booking is attributed to that agent for commission.
+// Customers always book for themselves and agents for their own commission, so the user is only
+// required when booking on behalf of a customer.
+type BookingCreateRequest struct {
+	ProductID        uint       `json:"product_id" validate:"required"`
+	UserID           uint       `json:"user_id,omitempty"`
+	TotalQty         int        `json:"total_qty" validate:"required,gt=0"`
+	Date             *time.Time `json:"date,omitempty"`
+	DepartureBatchID *uint      `json:"departure_batch_id,omitempty"`
+	VoucherCode      *string    `json:"voucher_code,omitempty" validate:"omitempty,max=50"`
+	AgentID          *uint      `json:"agent_id,omitempty"`
+}
+
+// BookingUpdateRequest defines the payload for updating an existing booking.
+// All fields are optional to allow partial updates.
+type BookingUpdateRequest struct {
+	TotalQty *int       `json:"total_qty,omitempty" validate:"omitempty,gt=0"`
+	Date     *time.Time `json:"date,omitempty"`
+}
+
+// BookingTransitionRequest defines the optional payload for booking lifecycle transitions
+// (confirm, start-trip, complete, cancel).
+type BookingTransitionRequest struct {
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// BookingBaseResponse defines the standard response structure for booking data.
+type BookingBaseResponse struct {
+	ID             uint       `json:"id"`
+	UID            string     `json:"uid"`
+	Code           string     `json:"code"`
+	Date           time.Time  `json:"date"`
+	ProductID      *uint      `json:"product_id,omitempty"`
+	ProductName    *string    `json:"product_name,omitempty"`
+	UserID         uint       `json:"user_id"`
+	UserFullName   string     `json:"user_full_name"`
+	TotalQty       int        `json:"total_qty"`
+	TotalAmount    string     `json:"total_amount"`
+	Status         string     `json:"status"`
+	PaymentStatus  string     `json:"payment_status"`
+	TotalPayment   string     `json:"total_payment"`
+	MaxPaymentTime *time.Time `json:"max_payment_time,omitempty"`
+	CreatedOn      time.Time  `json:"created_on"`
+
+	InstallmentRequestStatus *string `json:"installment_request_status,omitempty"`
+	DepartureBatchID         *uint   `json:"departure_batch_id,omitempty"`
+	VoucherID                *uint   `json:"voucher_id,omitempty"`
+	DiscountAmount           string  `json:"discount_amount"`
+	AgentID                  *uint   `json:"agent_id,omitempty"`
+}
+
+// BookingStatusHistoryResponse defines the response structure for a single booking status transition.
+type BookingStatusHistoryResponse struct {
+	ID         uint            `json:"id"`
+	FromStatus *string         `json:"from_status,omitempty"`
+	ToStatus   string          `json:"to_status"`
+	Reason     *string         `json:"reason,omitempty"`
+	CreatedBy  json.RawMessage `json:"created_by"`
+	CreatedOn  time.Time       `json:"created_on"`
+}
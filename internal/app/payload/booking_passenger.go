@@ -0,0 +1,49 @@
+package payload
+
+import (
+	"time"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// BookingPassengerRequest defines a single passenger entry of a booking manifest.
+type BookingPassengerRequest struct {
+	FullName       string `json:"full_name" validate:"required,max=255"`
+	Gender         string `json:"gender" validate:"required,oneof=male female"`
+	DateOfBirth    string `json:"date_of_birth" validate:"required,datetime=2006-01-02"`
+	PassportNumber string `json:"passport_number" validate:"required,alphanum,max=50"`
+	PassportExpiry string `json:"passport_expiry" validate:"required,datetime=2006-01-02"`
+	Relationship   string `json:"relationship" validate:"required,oneof=self spouse child parent sibling relative other"`
+}
+
+// BookingPassengerReplaceRequest defines the payload that replaces the whole passenger manifest of a booking.
+type BookingPassengerReplaceRequest struct {
+	Passengers []BookingPassengerRequest `json:"passengers" validate:"required,min=1,dive"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// BookingPassengerResponse defines the response structure for a single passenger.
+type BookingPassengerResponse struct {
+	ID             uint      `json:"id"`
+	UID            string    `json:"uid"`
+	FullName       string    `json:"full_name"`
+	Gender         string    `json:"gender"`
+	DateOfBirth    time.Time `json:"date_of_birth"`
+	PassportNumber string    `json:"passport_number"`
+	PassportExpiry time.Time `json:"passport_expiry"`
+	Relationship   string    `json:"relationship"`
+}
+
+// BookingPassengerManifestResponse defines the response structure for the passenger manifest of a booking.
+// IsComplete reports whether the number of passengers matches the booking quantity.
+type BookingPassengerManifestResponse struct {
+	BookingID  uint                       `json:"booking_id"`
+	TotalQty   int                        `json:"total_qty"`
+	IsComplete bool                       `json:"is_complete"`
+	Passengers []BookingPassengerResponse `json:"passengers"`
+}
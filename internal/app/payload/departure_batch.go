@@ -0,0 +1,53 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// DepartureBatchGetAllRequest defines the query parameters for retrieving a list of departure batches.
+// It combines common pagination/sorting parameters with specific departure batch filters.
+type DepartureBatchGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.DepartureBatchFilter
+}
+
+// DepartureBatchCreateRequest defines the payload required to schedule a departure batch for a product.
+type DepartureBatchCreateRequest struct {
+	DepartureDate string `json:"departure_date" validate:"required,datetime=2006-01-02"`
+	ReturnDate    string `json:"return_date" validate:"required,datetime=2006-01-02"`
+	Quota         int    `json:"quota" validate:"required,gt=0"`
+	IsActive      *bool  `json:"is_active,omitempty" validate:"omitempty"`
+}
+
+// DepartureBatchUpdateRequest defines the payload for updating an existing departure batch.
+// All fields are optional to allow partial updates.
+type DepartureBatchUpdateRequest struct {
+	DepartureDate *string `json:"departure_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	ReturnDate    *string `json:"return_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Quota         *int    `json:"quota,omitempty" validate:"omitempty,gt=0"`
+	IsActive      *bool   `json:"is_active,omitempty" validate:"omitempty"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// DepartureBatchBaseResponse defines the standard response structure for departure batch data.
+type DepartureBatchBaseResponse struct {
+	ID             uint      `json:"id"`
+	UID            string    `json:"uid"`
+	ProductID      uint      `json:"product_id"`
+	DepartureDate  time.Time `json:"departure_date"`
+	ReturnDate     time.Time `json:"return_date"`
+	Quota          int       `json:"quota"`
+	SeatsTaken     int       `json:"seats_taken"`
+	SeatsAvailable int       `json:"seats_available"`
+	IsActive       *bool     `json:"is_active"`
+	CreatedOn      time.Time `json:"created_on"`
+}
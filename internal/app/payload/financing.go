@@ -0,0 +1,71 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// FinancingInstitutionGetAllRequest defines the query parameters for retrieving a list of financing institutions.
+type FinancingInstitutionGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.FinancingInstitutionFilter
+}
+
+// FinancingInstitutionCreateRequest defines the payload required to register a financing institution.
+type FinancingInstitutionCreateRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+// FinancingRequestGetAllRequest defines the query parameters for retrieving the financing requests of an institution.
+type FinancingRequestGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.InstallmentPlanFilter
+}
+
+// FinancingApproveRequest defines the terms under which a financing institution approves a request.
+// The approved terms replace the ones proposed by the booker.
+type FinancingApproveRequest struct {
+	Terms          int     `json:"terms" validate:"required,gte=2,lte=60"`
+	FirstDueDate   string  `json:"first_due_date" validate:"required,datetime=2006-01-02"`
+	IntervalMonths *int    `json:"interval_months,omitempty" validate:"omitempty,gte=1,lte=12"`
+	Notes          *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// FinancingRejectRequest defines the payload required to reject a financing request.
+type FinancingRejectRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// FinancingDisbursementCreateRequest defines the payload a financing institution reports when it pays out an approved request.
+type FinancingDisbursementCreateRequest struct {
+	Amount      string     `json:"amount" validate:"required"`
+	Reference   *string    `json:"reference,omitempty" validate:"omitempty,max=255"`
+	DisbursedAt *time.Time `json:"disbursed_at,omitempty"`
+	Notes       *string    `json:"notes,omitempty"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// FinancingInstitutionResponse defines the response structure for a financing institution.
+type FinancingInstitutionResponse struct {
+	ID        uint      `json:"id"`
+	UID       string    `json:"uid"`
+	Name      string    `json:"name"`
+	IsActive  *bool     `json:"is_active"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// FinancingDisbursementResponse defines the response returned after recording a disbursement,
+// including the payment it was recorded as and the recalculated payment state of the booking.
+type FinancingDisbursementResponse struct {
+	Plan    InstallmentPlanResponse `json:"plan"`
+	Payment PaymentBaseResponse     `json:"payment"`
+	Booking BookingBaseResponse     `json:"booking"`
+}
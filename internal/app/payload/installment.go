@@ -0,0 +1,76 @@
+package payload
+
+import "time"
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// InstallmentPlanCreateRequest defines the payload required to request an installment plan for a booking.
+// The outstanding balance of the booking is split evenly across the requested number of terms.
+// When FinancingInstitutionID is set, the request is routed to that institution for a decision.
+type InstallmentPlanCreateRequest struct {
+	Terms                  int    `json:"terms" validate:"required,gte=2,lte=36"`
+	FirstDueDate           string `json:"first_due_date" validate:"required,datetime=2006-01-02"`
+	IntervalMonths         *int   `json:"interval_months,omitempty" validate:"omitempty,gte=1,lte=12"`
+	FinancingInstitutionID *uint  `json:"financing_institution_id,omitempty" validate:"omitempty,gt=0"`
+}
+
+// InstallmentPlanRejectRequest defines the payload required to reject an installment plan.
+type InstallmentPlanRejectRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// InstallmentOverdueGetAllRequest defines the query parameters for retrieving overdue installment dues.
+type InstallmentOverdueGetAllRequest struct {
+	*CommonGetAllRequest
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// InstallmentDueResponse defines the response structure for a single installment due.
+type InstallmentDueResponse struct {
+	ID         uint       `json:"id,omitempty"`
+	Sequence   int        `json:"sequence"`
+	DueDate    time.Time  `json:"due_date"`
+	Amount     string     `json:"amount"`
+	PaidAmount string     `json:"paid_amount"`
+	PaidOn     *time.Time `json:"paid_on,omitempty"`
+}
+
+// InstallmentPlanResponse defines the standard response structure for installment plan data.
+// For plans awaiting approval and plans financed by an institution, Dues contains the proposed schedule.
+type InstallmentPlanResponse struct {
+	ID             uint                     `json:"id"`
+	UID            string                   `json:"uid"`
+	BookingID      uint                     `json:"booking_id"`
+	Status         string                   `json:"status"`
+	Terms          int                      `json:"terms"`
+	IntervalMonths int                      `json:"interval_months"`
+	FirstDueDate   time.Time                `json:"first_due_date"`
+	Amount         string                   `json:"amount"`
+	ApprovedOn     *time.Time               `json:"approved_on,omitempty"`
+	RejectedOn     *time.Time               `json:"rejected_on,omitempty"`
+	DecisionNotes  *string                  `json:"decision_notes,omitempty"`
+	Dues           []InstallmentDueResponse `json:"dues"`
+	CreatedOn      time.Time                `json:"created_on"`
+
+	FinancingInstitutionID *uint      `json:"financing_institution_id,omitempty"`
+	DisbursementPaymentID  *uint      `json:"disbursement_payment_id,omitempty"`
+	DisbursedOn            *time.Time `json:"disbursed_on,omitempty"`
+}
+
+// InstallmentOverdueResponse defines the response structure for an overdue installment due, used for collections.
+type InstallmentOverdueResponse struct {
+	ID          uint      `json:"id"`
+	PlanID      uint      `json:"plan_id"`
+	BookingID   uint      `json:"booking_id"`
+	Sequence    int       `json:"sequence"`
+	DueDate     time.Time `json:"due_date"`
+	Amount      string    `json:"amount"`
+	PaidAmount  string    `json:"paid_amount"`
+	Outstanding string    `json:"outstanding"`
+	DaysOverdue int       `json:"days_overdue"`
+}
@@ -0,0 +1,62 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// InvoiceGetAllRequest defines the query parameters for retrieving a list of invoices.
+// It combines common pagination/sorting parameters with specific invoice filters.
+type InvoiceGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.InvoiceFilter
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// InvoiceLineResponse defines the response structure for a single invoice line.
+type InvoiceLineResponse struct {
+	LineNo         int    `json:"line_no"`
+	ProductID      *uint  `json:"product_id,omitempty"`
+	Description    string `json:"description"`
+	Quantity       int    `json:"quantity"`
+	UnitPrice      string `json:"unit_price"`
+	DiscountAmount string `json:"discount_amount"`
+	TaxAmount      string `json:"tax_amount"`
+	TotalAmount    string `json:"total_amount"`
+}
+
+// InvoiceBaseResponse defines the standard response structure for invoice data.
+type InvoiceBaseResponse struct {
+	ID             uint                  `json:"id"`
+	UID            string                `json:"uid"`
+	BookingID      uint                  `json:"booking_id"`
+	Number         string                `json:"number"`
+	Period         string                `json:"period"`
+	IssuedOn       time.Time             `json:"issued_on"`
+	BookingCode    string                `json:"booking_code"`
+	CustomerName   string                `json:"customer_name"`
+	Subtotal       string                `json:"subtotal"`
+	DiscountAmount string                `json:"discount_amount"`
+	TaxPercent     string                `json:"tax_percent"`
+	TaxAmount      string                `json:"tax_amount"`
+	TotalAmount    string                `json:"total_amount"`
+	Lines          []InvoiceLineResponse `json:"lines,omitempty"`
+
+	ReplacesInvoiceID *uint      `json:"replaces_invoice_id,omitempty"`
+	VoidedOn          *time.Time `json:"voided_on,omitempty"`
+}
+
+// FileResponse carries a rendered document to be sent as a download.
+type FileResponse struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
@@ -0,0 +1,58 @@
+package payload
+
+import "time"
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// MuthawifAssignmentCreateRequest defines the payload required to assign a muthawif to a departure batch.
+type MuthawifAssignmentCreateRequest struct {
+	MuthawifID uint    `json:"muthawif_id" validate:"required"`
+	Notes      *string `json:"notes,omitempty"`
+}
+
+// MuthawifTripGetAllRequest defines the query parameters for retrieving the upcoming trips of a muthawif.
+type MuthawifTripGetAllRequest struct {
+	*CommonGetAllRequest
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// MuthawifAssignmentResponse defines the response structure for a single muthawif assignment.
+type MuthawifAssignmentResponse struct {
+	ID               uint      `json:"id"`
+	UID              string    `json:"uid"`
+	DepartureBatchID uint      `json:"departure_batch_id"`
+	MuthawifID       uint      `json:"muthawif_id"`
+	DepartureDate    time.Time `json:"departure_date"`
+	ReturnDate       time.Time `json:"return_date"`
+	Notes            *string   `json:"notes,omitempty"`
+	CreatedOn        time.Time `json:"created_on"`
+}
+
+// MuthawifTripBookingResponse defines the response structure for a booking on a muthawif's trip.
+type MuthawifTripBookingResponse struct {
+	ID           uint   `json:"id"`
+	Code         string `json:"code"`
+	UserID       uint   `json:"user_id"`
+	UserFullName string `json:"user_full_name"`
+	TotalQty     int    `json:"total_qty"`
+	Status       string `json:"status"`
+}
+
+// MuthawifTripResponse defines the response structure for an upcoming trip of a muthawif and its bookings.
+type MuthawifTripResponse struct {
+	AssignmentID     uint                          `json:"assignment_id"`
+	DepartureBatchID uint                          `json:"departure_batch_id"`
+	ProductID        uint                          `json:"product_id"`
+	ProductName      string                        `json:"product_name"`
+	DepartureDate    time.Time                     `json:"departure_date"`
+	ReturnDate       time.Time                     `json:"return_date"`
+	Quota            int                           `json:"quota"`
+	SeatsTaken       int                           `json:"seats_taken"`
+	Notes            *string                       `json:"notes,omitempty"`
+	Bookings         []MuthawifTripBookingResponse `json:"bookings"`
+}
@@ -0,0 +1,51 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// PaymentGetAllRequest defines the query parameters for retrieving a list of payments.
+// It combines common pagination/sorting parameters with specific payment filters.
+type PaymentGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.PaymentFilter
+}
+
+// PaymentCreateRequest defines the payload required to record a payment against a booking.
+type PaymentCreateRequest struct {
+	Amount    string     `json:"amount" validate:"required"`
+	Method    string     `json:"method" validate:"required,oneof=bank_transfer virtual_account credit_card e_wallet cash wallet"`
+	Reference *string    `json:"reference,omitempty" validate:"omitempty,max=255"`
+	PaidAt    *time.Time `json:"paid_at,omitempty"`
+	Notes     *string    `json:"notes,omitempty"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// PaymentBaseResponse defines the standard response structure for payment data.
+type PaymentBaseResponse struct {
+	ID        uint      `json:"id"`
+	UID       string    `json:"uid"`
+	BookingID uint      `json:"booking_id"`
+	Amount    string    `json:"amount"`
+	Method    string    `json:"method"`
+	Reference *string   `json:"reference,omitempty"`
+	PaidAt    time.Time `json:"paid_at"`
+	Notes     *string   `json:"notes,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// PaymentRecordResponse defines the response returned after recording a payment,
+// including the recalculated payment state of the booking.
+type PaymentRecordResponse struct {
+	Payment PaymentBaseResponse `json:"payment"`
+	Booking BookingBaseResponse `json:"booking"`
+}
@@ -0,0 +1,83 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// ReferralGetAllRequest defines the query parameters for retrieving referrals.
+type ReferralGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.ReferralFilter
+}
+
+// ReferralTreeRequest defines the query parameters for retrieving the referral tree of a user.
+type ReferralTreeRequest struct {
+	Depth *int `query:"depth" validate:"omitempty,min=1,max=10"`
+}
+
+// ReferralCodeUpdateRequest defines the payload required to choose a custom referral code.
+// Codes are case-insensitive and stored in upper case.
+type ReferralCodeUpdateRequest struct {
+	Code string `json:"code" validate:"required,min=4,max=20,alphanum"`
+}
+
+// ReferralApplyRequest defines the payload required to register a user as referred by the owner of a code.
+type ReferralApplyRequest struct {
+	Code string `json:"code" validate:"required,max=20,alphanum"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// ReferralCodeResponse defines the response structure for the referral code of a user.
+type ReferralCodeResponse struct {
+	UserID uint   `json:"user_id"`
+	Code   string `json:"code"`
+}
+
+// ReferralResponse defines the response structure for a single referral and its reward payout.
+type ReferralResponse struct {
+	ID                  uint       `json:"id"`
+	UID                 string     `json:"uid"`
+	ReferrerID          uint       `json:"referrer_id"`
+	RefereeID           uint       `json:"referee_id"`
+	ReferralCode        string     `json:"referral_code"`
+	Status              string     `json:"status"`
+	RewardAmount        *string    `json:"reward_amount,omitempty"`
+	RewardedBookingID   *uint      `json:"rewarded_booking_id,omitempty"`
+	RewardedOn          *time.Time `json:"rewarded_on,omitempty"`
+	WalletTransactionID *uint      `json:"wallet_transaction_id,omitempty"`
+	CreatedOn           time.Time  `json:"created_on"`
+}
+
+// ReferralTreeNode defines the response structure for a referred user and the users they referred in turn.
+type ReferralTreeNode struct {
+	ReferralID   uint                `json:"referral_id"`
+	UserID       uint                `json:"user_id"`
+	FullName     string              `json:"full_name"`
+	Depth        int                 `json:"depth"`
+	Status       string              `json:"status"`
+	RewardAmount *string             `json:"reward_amount,omitempty"`
+	RewardedOn   *time.Time          `json:"rewarded_on,omitempty"`
+	CreatedOn    time.Time           `json:"created_on"`
+	Referrals    []*ReferralTreeNode `json:"referrals"`
+}
+
+// ReferralTreeResponse defines the response structure for the referral tree below a user.
+// Totals cover every referral in the returned tree.
+type ReferralTreeResponse struct {
+	UserID              uint                `json:"user_id"`
+	FullName            string              `json:"full_name"`
+	ReferralCode        *string             `json:"referral_code,omitempty"`
+	Depth               int                 `json:"depth"`
+	TotalReferrals      int                 `json:"total_referrals"`
+	TotalRewardedAmount string              `json:"total_rewarded_amount"`
+	Referrals           []*ReferralTreeNode `json:"referrals"`
+}
@@ -0,0 +1,57 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// RefundGetAllRequest defines the query parameters for retrieving a list of refunds.
+// It combines common pagination/sorting parameters with specific refund filters.
+type RefundGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.RefundFilter
+}
+
+// RefundCreateRequest defines the payload required to request a refund on a booking.
+type RefundCreateRequest struct {
+	Amount string  `json:"amount" validate:"required"`
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=1000"`
+}
+
+// RefundRejectRequest defines the payload required to reject a refund request.
+type RefundRejectRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// RefundProcessRequest defines the optional payload for marking a refund as processed by finance.
+// Destination selects where the money goes on completion: the customer's bank account (default) or wallet.
+type RefundProcessRequest struct {
+	Reference   *string `json:"reference,omitempty" validate:"omitempty,max=255"`
+	Destination *string `json:"destination,omitempty" validate:"omitempty,oneof=bank wallet"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// RefundBaseResponse defines the standard response structure for refund data.
+type RefundBaseResponse struct {
+	ID              uint       `json:"id"`
+	UID             string     `json:"uid"`
+	BookingID       uint       `json:"booking_id"`
+	Amount          string     `json:"amount"`
+	Status          string     `json:"status"`
+	Reason          *string    `json:"reason,omitempty"`
+	RejectionReason *string    `json:"rejection_reason,omitempty"`
+	Reference       *string    `json:"reference,omitempty"`
+	Destination     string     `json:"destination"`
+	ReviewedOn      *time.Time `json:"reviewed_on,omitempty"`
+	ProcessedOn     *time.Time `json:"processed_on,omitempty"`
+	CompletedOn     *time.Time `json:"completed_on,omitempty"`
+	CreatedOn       time.Time  `json:"created_on"`
+}
@@ -0,0 +1,69 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// RescheduleGetAllRequest defines the query parameters for retrieving a list of reschedules.
+// It combines common pagination/sorting parameters with specific reschedule filters.
+type RescheduleGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.RescheduleFilter
+}
+
+// RescheduleCreateRequest defines the payload required to request moving a booking to another product and travel date.
+// When a departure batch is given, the travel date is taken from its departure date.
+type RescheduleCreateRequest struct {
+	ProductID        uint       `json:"product_id" validate:"required"`
+	Date             *time.Time `json:"date,omitempty" validate:"required_without=DepartureBatchID"`
+	DepartureBatchID *uint      `json:"departure_batch_id,omitempty"`
+	Reason           *string    `json:"reason,omitempty" validate:"omitempty,max=1000"`
+}
+
+// RescheduleRejectRequest defines the payload required to reject a reschedule request.
+type RescheduleRejectRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// RescheduleBaseResponse defines the standard response structure for reschedule data.
+// A positive price difference is an extra charge for the customer, a negative one a credit.
+type RescheduleBaseResponse struct {
+	ID              uint       `json:"id"`
+	UID             string     `json:"uid"`
+	BookingID       uint       `json:"booking_id"`
+	Status          string     `json:"status"`
+	FromProductID   *uint      `json:"from_product_id,omitempty"`
+	FromProductName *string    `json:"from_product_name,omitempty"`
+	FromDate        time.Time  `json:"from_date"`
+	FromTotalAmount string     `json:"from_total_amount"`
+	ToProductID     uint       `json:"to_product_id"`
+	ToProductName   string     `json:"to_product_name"`
+	ToDate          time.Time  `json:"to_date"`
+	ToTotalAmount   string     `json:"to_total_amount"`
+	PriceDifference string     `json:"price_difference"`
+	Reason          *string    `json:"reason,omitempty"`
+	RejectionReason *string    `json:"rejection_reason,omitempty"`
+	ReviewedOn      *time.Time `json:"reviewed_on,omitempty"`
+	ProcessedOn     *time.Time `json:"processed_on,omitempty"`
+	CreatedOn       time.Time  `json:"created_on"`
+
+	FromDepartureBatchID *uint `json:"from_departure_batch_id,omitempty"`
+	ToDepartureBatchID   *uint `json:"to_departure_batch_id,omitempty"`
+}
+
+// RescheduleProcessResponse defines the response returned after processing a reschedule,
+// including the updated booking.
+type RescheduleProcessResponse struct {
+	Reschedule RescheduleBaseResponse `json:"reschedule"`
+	Booking    BookingBaseResponse    `json:"booking"`
+}
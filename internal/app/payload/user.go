@@ -0,0 +1,76 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// UserGetAllRequest defines the query parameters for retrieving a list of users.
+// It combines common pagination/sorting parameters with specific user filters.
+type UserGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.UserFilter
+}
+
+// UserRegisterRequest defines the payload required to register a new customer account.
+// Password length is capped at 72 bytes, the most bcrypt takes into account.
+type UserRegisterRequest struct {
+	FullName     string  `json:"full_name" validate:"required,max=255"`
+	Gender       string  `json:"gender" validate:"required,oneof=male female"`
+	Email        *string `json:"email,omitempty" validate:"omitempty,email,max=320"`
+	Phone        string  `json:"phone" validate:"required,max=50"`
+	Password     string  `json:"password" validate:"required,min=8,max=72"`
+	ReferralCode *string `json:"referral_code,omitempty" validate:"omitempty,max=20"`
+}
+
+// UserUpdateRequest defines the payload for updating a user's profile.
+// All fields are optional to allow partial updates.
+type UserUpdateRequest struct {
+	FullName *string `json:"full_name,omitempty" validate:"omitempty,max=255"`
+	Gender   *string `json:"gender,omitempty" validate:"omitempty,oneof=male female"`
+	Email    *string `json:"email,omitempty" validate:"omitempty,email,max=320"`
+	Phone    *string `json:"phone,omitempty" validate:"omitempty,max=50"`
+}
+
+// UserVerifyEmailRequest defines the payload required to verify an email address
+// with the token from a verification link.
+type UserVerifyEmailRequest struct {
+	Token string `json:"token" validate:"required,max=2048"`
+}
+
+// UserForgotPasswordRequest defines the payload required to request a password reset link.
+type UserForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email,max=320"`
+}
+
+// UserResetPasswordRequest defines the payload required to set a new password with the token from a reset link.
+// Password length is capped at 72 bytes, the most bcrypt takes into account.
+type UserResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required,max=255"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// UserResponse defines the standard response structure for user data.
+// The password hash is never exposed; EmailVerified is derived from model.User.EmailVerified.
+type UserResponse struct {
+	ID            uint      `json:"id"`
+	UID           string    `json:"uid"`
+	FullName      string    `json:"full_name"`
+	Gender        string    `json:"gender"`
+	Email         *string   `json:"email,omitempty"`
+	EmailVerified bool      `json:"email_verified"`
+	Phone         string    `json:"phone"`
+	Role          string    `json:"role"`
+	IsActive      *bool     `json:"is_active"`
+	ReferralCode  *string   `json:"referral_code,omitempty"`
+	CreatedOn     time.Time `json:"created_on"`
+}
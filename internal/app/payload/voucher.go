@@ -0,0 +1,93 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// VoucherGetAllRequest defines the query parameters for retrieving a list of vouchers.
+// It combines common pagination/sorting parameters with specific voucher filters.
+type VoucherGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.VoucherFilter
+}
+
+// VoucherRedemptionGetAllRequest defines the query parameters for retrieving the redemptions of a voucher.
+type VoucherRedemptionGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.VoucherRedemptionFilter
+}
+
+// VoucherCreateRequest defines the payload required to create a new voucher.
+// Percentage discounts are expressed in percent and may be capped with MaxDiscount;
+// an empty ProductIDs list makes the voucher valid for every product.
+type VoucherCreateRequest struct {
+	Code           string    `json:"code" validate:"required,alphanum,max=50"`
+	Name           string    `json:"name" validate:"required,max=255"`
+	Description    *string   `json:"description,omitempty"`
+	DiscountType   string    `json:"discount_type" validate:"required,oneof=percentage fixed"`
+	DiscountValue  string    `json:"discount_value" validate:"required"`
+	MaxDiscount    *string   `json:"max_discount,omitempty"`
+	MinOrderAmount *string   `json:"min_order_amount,omitempty"`
+	ValidFrom      time.Time `json:"valid_from" validate:"required"`
+	ValidUntil     time.Time `json:"valid_until" validate:"required,gtfield=ValidFrom"`
+	UsageLimit     *int      `json:"usage_limit,omitempty" validate:"omitempty,gt=0"`
+	PerUserLimit   *int      `json:"per_user_limit,omitempty" validate:"omitempty,gt=0"`
+	ProductIDs     []uint    `json:"product_ids,omitempty"`
+	IsActive       *bool     `json:"is_active,omitempty" validate:"omitempty"`
+}
+
+// VoucherUpdateRequest defines the payload for updating an existing voucher.
+// The code and discount cannot be changed once created; all other fields are optional.
+// When ProductIDs is present, it replaces the voucher's product restrictions.
+type VoucherUpdateRequest struct {
+	Name           *string    `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description    *string    `json:"description,omitempty"`
+	MinOrderAmount *string    `json:"min_order_amount,omitempty"`
+	ValidFrom      *time.Time `json:"valid_from,omitempty"`
+	ValidUntil     *time.Time `json:"valid_until,omitempty"`
+	UsageLimit     *int       `json:"usage_limit,omitempty" validate:"omitempty,gt=0"`
+	PerUserLimit   *int       `json:"per_user_limit,omitempty" validate:"omitempty,gt=0"`
+	ProductIDs     *[]uint    `json:"product_ids,omitempty"`
+	IsActive       *bool      `json:"is_active,omitempty" validate:"omitempty"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// VoucherBaseResponse defines the standard response structure for voucher data.
+type VoucherBaseResponse struct {
+	ID             uint      `json:"id"`
+	UID            string    `json:"uid"`
+	Code           string    `json:"code"`
+	Name           string    `json:"name"`
+	Description    *string   `json:"description,omitempty"`
+	DiscountType   string    `json:"discount_type"`
+	DiscountValue  string    `json:"discount_value"`
+	MaxDiscount    *string   `json:"max_discount,omitempty"`
+	MinOrderAmount string    `json:"min_order_amount"`
+	ValidFrom      time.Time `json:"valid_from"`
+	ValidUntil     time.Time `json:"valid_until"`
+	UsageLimit     *int      `json:"usage_limit,omitempty"`
+	PerUserLimit   *int      `json:"per_user_limit,omitempty"`
+	UsedCount      int       `json:"used_count"`
+	ProductIDs     []uint    `json:"product_ids"`
+	IsActive       *bool     `json:"is_active"`
+	CreatedOn      time.Time `json:"created_on"`
+}
+
+// VoucherRedemptionResponse defines the response structure for a single voucher redemption.
+type VoucherRedemptionResponse struct {
+	ID             uint      `json:"id"`
+	VoucherID      uint      `json:"voucher_id"`
+	BookingID      uint      `json:"booking_id"`
+	UserID         uint      `json:"user_id"`
+	DiscountAmount string    `json:"discount_amount"`
+	CreatedOn      time.Time `json:"created_on"`
+}
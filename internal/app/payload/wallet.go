@@ -0,0 +1,87 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/model"
+)
+
+// ==========================================================
+// Request DTOs
+// ==========================================================
+
+// WalletTransactionGetAllRequest defines the query parameters for retrieving the transactions of a wallet.
+type WalletTransactionGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.WalletTransactionFilter
+}
+
+// WalletHoldGetAllRequest defines the query parameters for retrieving the holds of a wallet.
+type WalletHoldGetAllRequest struct {
+	*CommonGetAllRequest
+	*model.WalletHoldFilter
+}
+
+// WalletCreditRequest defines the payload required to add money to a wallet.
+// Refunds and booking payments are posted by their own workflows and cannot be credited directly.
+type WalletCreditRequest struct {
+	Type        string  `json:"type" validate:"required,oneof=top_up cashback"`
+	Amount      string  `json:"amount" validate:"required"`
+	BookingID   *uint   `json:"booking_id,omitempty" validate:"omitempty"`
+	Reference   *string `json:"reference,omitempty" validate:"omitempty,max=255"`
+	Description *string `json:"description,omitempty"`
+}
+
+// WalletHoldCreateRequest defines the payload required to freeze part of a wallet balance.
+// The funds are reserved for a booking of the wallet's owner and, optionally, one of its payments.
+type WalletHoldCreateRequest struct {
+	Amount    string `json:"amount" validate:"required"`
+	Reason    string `json:"reason" validate:"required,max=1000"`
+	BookingID uint   `json:"booking_id" validate:"required,gt=0"`
+	PaymentID *uint  `json:"payment_id,omitempty" validate:"omitempty,gt=0"`
+}
+
+// ==========================================================
+// Response DTOs
+// ==========================================================
+
+// WalletResponse defines the response structure for a wallet and its balance.
+type WalletResponse struct {
+	ID               uint   `json:"id"`
+	UID              string `json:"uid"`
+	UserID           uint   `json:"user_id"`
+	Balance          string `json:"balance"`
+	HeldAmount       string `json:"held_amount"`
+	AvailableBalance string `json:"available_balance"`
+}
+
+// WalletTransactionResponse defines the response structure for a single wallet transaction.
+type WalletTransactionResponse struct {
+	ID           uint      `json:"id"`
+	UID          string    `json:"uid"`
+	WalletID     uint      `json:"wallet_id"`
+	Type         string    `json:"type"`
+	Direction    string    `json:"direction"`
+	Amount       string    `json:"amount"`
+	BalanceAfter string    `json:"balance_after"`
+	BookingID    *uint     `json:"booking_id,omitempty"`
+	PaymentID    *uint     `json:"payment_id,omitempty"`
+	RefundID     *uint     `json:"refund_id,omitempty"`
+	Reference    *string   `json:"reference,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	CreatedOn    time.Time `json:"created_on"`
+}
+
+// WalletHoldResponse defines the response structure for a single wallet hold.
+type WalletHoldResponse struct {
+	ID         uint       `json:"id"`
+	UID        string     `json:"uid"`
+	WalletID   uint       `json:"wallet_id"`
+	BookingID  *uint      `json:"booking_id,omitempty"`
+	PaymentID  *uint      `json:"payment_id,omitempty"`
+	Amount     string     `json:"amount"`
+	Status     string     `json:"status"`
+	Reason     string     `json:"reason"`
+	ReleasedOn *time.Time `json:"released_on,omitempty"`
+	CreatedOn  time.Time  `json:"created_on"`
+}
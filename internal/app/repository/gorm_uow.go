@@ -8,7 +8,24 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/admin"
+	"github.com/aburizalpurnama/travel/internal/app/domain/agent"
+	"github.com/aburizalpurnama/travel/internal/app/domain/auth"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/domain/departure"
+	"github.com/aburizalpurnama/travel/internal/app/domain/financing"
+	"github.com/aburizalpurnama/travel/internal/app/domain/installment"
+	"github.com/aburizalpurnama/travel/internal/app/domain/invoice"
+	"github.com/aburizalpurnama/travel/internal/app/domain/muthawif"
+	"github.com/aburizalpurnama/travel/internal/app/domain/passenger"
+	"github.com/aburizalpurnama/travel/internal/app/domain/payment"
 	"github.com/aburizalpurnama/travel/internal/app/domain/product"
+	"github.com/aburizalpurnama/travel/internal/app/domain/referral"
+	"github.com/aburizalpurnama/travel/internal/app/domain/refund"
+	"github.com/aburizalpurnama/travel/internal/app/domain/reschedule"
+	"github.com/aburizalpurnama/travel/internal/app/domain/user"
+	"github.com/aburizalpurnama/travel/internal/app/domain/voucher"
+	"github.com/aburizalpurnama/travel/internal/app/domain/wallet"
 )
 
 var tracer trace.Tracer = otel.Tracer("repository.uow")
@@ -20,6 +37,33 @@ type gormUnitOfWork struct {
 
 	// Caches for lazy-loaded repositories
 	productRepo contract.ProductRepository
+	userRepo    contract.UserRepository
+	bookingRepo contract.BookingRepository
+
+	bookingStatusHistoryRepo contract.BookingStatusHistoryRepository
+	paymentRepo              contract.PaymentRepository
+	installmentPlanRepo      contract.InstallmentPlanRepository
+	installmentDueRepo       contract.InstallmentDueRepository
+	refundRepo               contract.RefundRepository
+	rescheduleRepo           contract.RescheduleRepository
+	departureBatchRepo       contract.DepartureBatchRepository
+	voucherRepo              contract.VoucherRepository
+	voucherRedemptionRepo    contract.VoucherRedemptionRepository
+	bookingPassengerRepo     contract.BookingPassengerRepository
+	invoiceRepo              contract.InvoiceRepository
+	walletRepo               contract.WalletRepository
+	walletTransactionRepo    contract.WalletTransactionRepository
+	walletHoldRepo           contract.WalletHoldRepository
+	referralRepo             contract.ReferralRepository
+	muthawifAssignmentRepo   contract.MuthawifAssignmentRepository
+	adminRepo                contract.AdminRepository
+	financingInstitutionRepo contract.FinancingInstitutionRepository
+	commissionRateRepo       contract.CommissionRateRepository
+	agentCommissionRepo      contract.AgentCommissionRepository
+	refreshTokenRepo         contract.RefreshTokenRepository
+	accessTokenDenylistRepo  contract.AccessTokenDenylistRepository
+	verificationSendRepo     contract.EmailVerificationSendRepository
+	passwordResetTokenRepo   contract.PasswordResetTokenRepository
 }
 
 // NewGORMUnitOfWork creates a new UnitOfWork provider with GORM DB.
@@ -38,6 +82,214 @@ func (u *gormUnitOfWork) ProductRepository() contract.ProductRepository {
 	return u.productRepo
 }
 
+// UserRepository provides a lazy-loaded transactional UserRepository.
+func (u *gormUnitOfWork) UserRepository() contract.UserRepository {
+	if u.userRepo == nil {
+		u.userRepo = user.NewRepository(u.db)
+	}
+	return u.userRepo
+}
+
+// BookingRepository provides a lazy-loaded transactional BookingRepository.
+func (u *gormUnitOfWork) BookingRepository() contract.BookingRepository {
+	if u.bookingRepo == nil {
+		u.bookingRepo = booking.NewRepository(u.db)
+	}
+	return u.bookingRepo
+}
+
+// BookingStatusHistoryRepository provides a lazy-loaded transactional BookingStatusHistoryRepository.
+func (u *gormUnitOfWork) BookingStatusHistoryRepository() contract.BookingStatusHistoryRepository {
+	if u.bookingStatusHistoryRepo == nil {
+		u.bookingStatusHistoryRepo = booking.NewStatusHistoryRepository(u.db)
+	}
+	return u.bookingStatusHistoryRepo
+}
+
+// PaymentRepository provides a lazy-loaded transactional PaymentRepository.
+func (u *gormUnitOfWork) PaymentRepository() contract.PaymentRepository {
+	if u.paymentRepo == nil {
+		u.paymentRepo = payment.NewRepository(u.db)
+	}
+	return u.paymentRepo
+}
+
+// InstallmentPlanRepository provides a lazy-loaded transactional InstallmentPlanRepository.
+func (u *gormUnitOfWork) InstallmentPlanRepository() contract.InstallmentPlanRepository {
+	if u.installmentPlanRepo == nil {
+		u.installmentPlanRepo = installment.NewPlanRepository(u.db)
+	}
+	return u.installmentPlanRepo
+}
+
+// InstallmentDueRepository provides a lazy-loaded transactional InstallmentDueRepository.
+func (u *gormUnitOfWork) InstallmentDueRepository() contract.InstallmentDueRepository {
+	if u.installmentDueRepo == nil {
+		u.installmentDueRepo = installment.NewDueRepository(u.db)
+	}
+	return u.installmentDueRepo
+}
+
+// RefundRepository provides a lazy-loaded transactional RefundRepository.
+func (u *gormUnitOfWork) RefundRepository() contract.RefundRepository {
+	if u.refundRepo == nil {
+		u.refundRepo = refund.NewRepository(u.db)
+	}
+	return u.refundRepo
+}
+
+// RescheduleRepository provides a lazy-loaded transactional RescheduleRepository.
+func (u *gormUnitOfWork) RescheduleRepository() contract.RescheduleRepository {
+	if u.rescheduleRepo == nil {
+		u.rescheduleRepo = reschedule.NewRepository(u.db)
+	}
+	return u.rescheduleRepo
+}
+
+// DepartureBatchRepository provides a lazy-loaded transactional DepartureBatchRepository.
+func (u *gormUnitOfWork) DepartureBatchRepository() contract.DepartureBatchRepository {
+	if u.departureBatchRepo == nil {
+		u.departureBatchRepo = departure.NewRepository(u.db)
+	}
+	return u.departureBatchRepo
+}
+
+// VoucherRepository provides a lazy-loaded transactional VoucherRepository.
+func (u *gormUnitOfWork) VoucherRepository() contract.VoucherRepository {
+	if u.voucherRepo == nil {
+		u.voucherRepo = voucher.NewRepository(u.db)
+	}
+	return u.voucherRepo
+}
+
+// VoucherRedemptionRepository provides a lazy-loaded transactional VoucherRedemptionRepository.
+func (u *gormUnitOfWork) VoucherRedemptionRepository() contract.VoucherRedemptionRepository {
+	if u.voucherRedemptionRepo == nil {
+		u.voucherRedemptionRepo = voucher.NewRedemptionRepository(u.db)
+	}
+	return u.voucherRedemptionRepo
+}
+
+// BookingPassengerRepository provides a lazy-loaded transactional BookingPassengerRepository.
+func (u *gormUnitOfWork) BookingPassengerRepository() contract.BookingPassengerRepository {
+	if u.bookingPassengerRepo == nil {
+		u.bookingPassengerRepo = passenger.NewRepository(u.db)
+	}
+	return u.bookingPassengerRepo
+}
+
+// InvoiceRepository provides a lazy-loaded transactional InvoiceRepository.
+func (u *gormUnitOfWork) InvoiceRepository() contract.InvoiceRepository {
+	if u.invoiceRepo == nil {
+		u.invoiceRepo = invoice.NewRepository(u.db)
+	}
+	return u.invoiceRepo
+}
+
+// WalletRepository provides a lazy-loaded transactional WalletRepository.
+func (u *gormUnitOfWork) WalletRepository() contract.WalletRepository {
+	if u.walletRepo == nil {
+		u.walletRepo = wallet.NewRepository(u.db)
+	}
+	return u.walletRepo
+}
+
+// WalletTransactionRepository provides a lazy-loaded transactional WalletTransactionRepository.
+func (u *gormUnitOfWork) WalletTransactionRepository() contract.WalletTransactionRepository {
+	if u.walletTransactionRepo == nil {
+		u.walletTransactionRepo = wallet.NewTransactionRepository(u.db)
+	}
+	return u.walletTransactionRepo
+}
+
+// WalletHoldRepository provides a lazy-loaded transactional WalletHoldRepository.
+func (u *gormUnitOfWork) WalletHoldRepository() contract.WalletHoldRepository {
+	if u.walletHoldRepo == nil {
+		u.walletHoldRepo = wallet.NewHoldRepository(u.db)
+	}
+	return u.walletHoldRepo
+}
+
+// ReferralRepository provides a lazy-loaded transactional ReferralRepository.
+func (u *gormUnitOfWork) ReferralRepository() contract.ReferralRepository {
+	if u.referralRepo == nil {
+		u.referralRepo = referral.NewRepository(u.db)
+	}
+	return u.referralRepo
+}
+
+// MuthawifAssignmentRepository provides a lazy-loaded transactional MuthawifAssignmentRepository.
+func (u *gormUnitOfWork) MuthawifAssignmentRepository() contract.MuthawifAssignmentRepository {
+	if u.muthawifAssignmentRepo == nil {
+		u.muthawifAssignmentRepo = muthawif.NewRepository(u.db)
+	}
+	return u.muthawifAssignmentRepo
+}
+
+// AdminRepository provides a lazy-loaded transactional AdminRepository.
+func (u *gormUnitOfWork) AdminRepository() contract.AdminRepository {
+	if u.adminRepo == nil {
+		u.adminRepo = admin.NewRepository(u.db)
+	}
+	return u.adminRepo
+}
+
+// FinancingInstitutionRepository provides a lazy-loaded transactional FinancingInstitutionRepository.
+func (u *gormUnitOfWork) FinancingInstitutionRepository() contract.FinancingInstitutionRepository {
+	if u.financingInstitutionRepo == nil {
+		u.financingInstitutionRepo = financing.NewInstitutionRepository(u.db)
+	}
+	return u.financingInstitutionRepo
+}
+
+// CommissionRateRepository provides a lazy-loaded transactional CommissionRateRepository.
+func (u *gormUnitOfWork) CommissionRateRepository() contract.CommissionRateRepository {
+	if u.commissionRateRepo == nil {
+		u.commissionRateRepo = agent.NewCommissionRateRepository(u.db)
+	}
+	return u.commissionRateRepo
+}
+
+// AgentCommissionRepository provides a lazy-loaded transactional AgentCommissionRepository.
+func (u *gormUnitOfWork) AgentCommissionRepository() contract.AgentCommissionRepository {
+	if u.agentCommissionRepo == nil {
+		u.agentCommissionRepo = agent.NewCommissionRepository(u.db)
+	}
+	return u.agentCommissionRepo
+}
+
+// RefreshTokenRepository provides a lazy-loaded transactional RefreshTokenRepository.
+func (u *gormUnitOfWork) RefreshTokenRepository() contract.RefreshTokenRepository {
+	if u.refreshTokenRepo == nil {
+		u.refreshTokenRepo = auth.NewRefreshTokenRepository(u.db)
+	}
+	return u.refreshTokenRepo
+}
+
+// AccessTokenDenylistRepository provides a lazy-loaded transactional AccessTokenDenylistRepository.
+func (u *gormUnitOfWork) AccessTokenDenylistRepository() contract.AccessTokenDenylistRepository {
+	if u.accessTokenDenylistRepo == nil {
+		u.accessTokenDenylistRepo = auth.NewAccessTokenDenylistRepository(u.db)
+	}
+	return u.accessTokenDenylistRepo
+}
+
+// EmailVerificationSendRepository provides a lazy-loaded transactional EmailVerificationSendRepository.
+func (u *gormUnitOfWork) EmailVerificationSendRepository() contract.EmailVerificationSendRepository {
+	if u.verificationSendRepo == nil {
+		u.verificationSendRepo = user.NewVerificationSendRepository(u.db)
+	}
+	return u.verificationSendRepo
+}
+
+// PasswordResetTokenRepository provides a lazy-loaded transactional PasswordResetTokenRepository.
+func (u *gormUnitOfWork) PasswordResetTokenRepository() contract.PasswordResetTokenRepository {
+	if u.passwordResetTokenRepo == nil {
+		u.passwordResetTokenRepo = user.NewPasswordResetTokenRepository(u.db)
+	}
+	return u.passwordResetTokenRepo
+}
+
 // RunInTransaction runs the given function 'fn' within a single GORM transaction.
 // If 'fn' returns an error, GORM automatically performs a rollback.
 // If 'fn' succeeds, GORM automatically performs a commit.
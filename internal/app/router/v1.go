@@ -3,15 +3,56 @@ package router
 import (
 	"log/slog"
 
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/app/domain/admin"
+	"github.com/aburizalpurnama/travel/internal/app/domain/agent"
+	"github.com/aburizalpurnama/travel/internal/app/domain/auth"
+	"github.com/aburizalpurnama/travel/internal/app/domain/booking"
+	"github.com/aburizalpurnama/travel/internal/app/domain/departure"
+	"github.com/aburizalpurnama/travel/internal/app/domain/financing"
+	"github.com/aburizalpurnama/travel/internal/app/domain/installment"
+	"github.com/aburizalpurnama/travel/internal/app/domain/invoice"
+	"github.com/aburizalpurnama/travel/internal/app/domain/muthawif"
+	"github.com/aburizalpurnama/travel/internal/app/domain/passenger"
+	"github.com/aburizalpurnama/travel/internal/app/domain/payment"
 	"github.com/aburizalpurnama/travel/internal/app/domain/product"
+	"github.com/aburizalpurnama/travel/internal/app/domain/referral"
+	"github.com/aburizalpurnama/travel/internal/app/domain/refund"
+	"github.com/aburizalpurnama/travel/internal/app/domain/reschedule"
+	"github.com/aburizalpurnama/travel/internal/app/domain/user"
+	"github.com/aburizalpurnama/travel/internal/app/domain/voucher"
+	"github.com/aburizalpurnama/travel/internal/app/domain/wallet"
 	"github.com/aburizalpurnama/travel/internal/app/middleware"
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
 	"github.com/gofiber/fiber/v2"
 )
 
 // Option holds the dependencies required to configure the router.
 type Option struct {
-	Logger         *slog.Logger
+	Logger              *slog.Logger
+	AccessTokenManager  contract.AccessTokenManager
+	AccessTokenDenylist contract.AccessTokenDenylistRepository
+	Policy              *rbac.Policy
+	AuthHandler         *auth.Handler
+
 	ProductHandler *product.Handler
+	BookingHandler *booking.Handler
+	PaymentHandler *payment.Handler
+
+	InstallmentHandler *installment.Handler
+	RefundHandler      *refund.Handler
+	RescheduleHandler  *reschedule.Handler
+	DepartureHandler   *departure.Handler
+	VoucherHandler     *voucher.Handler
+	PassengerHandler   *passenger.Handler
+	InvoiceHandler     *invoice.Handler
+	WalletHandler      *wallet.Handler
+	ReferralHandler    *referral.Handler
+	MuthawifHandler    *muthawif.Handler
+	AgentHandler       *agent.Handler
+	FinancingHandler   *financing.Handler
+	UserHandler        *user.Handler
+	AdminHandler       *admin.Handler
 }
 
 // SetupRoutesV1 configures the API routes for version 1.
@@ -21,7 +62,35 @@ func SetupRoutesV1(app *fiber.App, opt *Option) {
 
 	// Global Middleware
 	api.Use(middleware.RequestLogger(opt.Logger))
+	api.Use(middleware.Authenticate(opt.AccessTokenManager, opt.AccessTokenDenylist, middleware.PublicRoutes(
+		"POST /api/v1/auth/login",
+		"POST /api/v1/auth/admin/login",
+		"POST /api/v1/auth/refresh",
+		"POST /api/v1/users",
+		"POST /api/v1/users/verify-email",
+		"POST /api/v1/users/forgot-password",
+		"POST /api/v1/users/reset-password",
+	)))
+
+	authz := middleware.NewAuthorizer(opt.Policy)
 
 	// Register domain-specific routes
-	product.NewRoute(api, opt.ProductHandler)
+	auth.NewRoute(api, opt.AuthHandler)
+	product.NewRoute(api, opt.ProductHandler, authz)
+	booking.NewRoute(api, opt.BookingHandler, authz)
+	payment.NewRoute(api, opt.PaymentHandler, authz)
+	installment.NewRoute(api, opt.InstallmentHandler, authz)
+	refund.NewRoute(api, opt.RefundHandler, authz)
+	reschedule.NewRoute(api, opt.RescheduleHandler, authz)
+	departure.NewRoute(api, opt.DepartureHandler, authz)
+	voucher.NewRoute(api, opt.VoucherHandler, authz)
+	passenger.NewRoute(api, opt.PassengerHandler)
+	invoice.NewRoute(api, opt.InvoiceHandler, authz)
+	wallet.NewRoute(api, opt.WalletHandler, authz)
+	referral.NewRoute(api, opt.ReferralHandler, authz)
+	muthawif.NewRoute(api, opt.MuthawifHandler, authz)
+	agent.NewRoute(api, opt.AgentHandler, authz)
+	financing.NewRoute(api, opt.FinancingHandler, authz)
+	user.NewRoute(api, opt.UserHandler, authz)
+	admin.NewRoute(api, opt.AdminHandler, authz)
 }
@@ -38,15 +38,69 @@ type Config struct {
 	RedisDB       int    `env:"REDIS_DB"       envDefault:"0"`
 
 	// Security Configuration
-	JwtSecret            string `env:"JWT_SECRET"`
-	JwtExpirationMinutes int    `env:"JWT_EXPIRATION_MINUTES" envDefault:"60"`
-	CORSAllowedOrigins   string `env:"CORS_ALLOWED_ORIGINS"   envDefault:"http://localhost:5173,http://localhost:3000"`
+	JwtSecret            string        `env:"JWT_SECRET"`
+	JwtExpirationMinutes int           `env:"JWT_EXPIRATION_MINUTES" envDefault:"60"`
+	RefreshTokenTTL      time.Duration `env:"REFRESH_TOKEN_TTL"      envDefault:"720h"` // Lifetime of a refresh token; each refresh issues a new one
+	CORSAllowedOrigins   string        `env:"CORS_ALLOWED_ORIGINS"   envDefault:"http://localhost:5173,http://localhost:3000"`
+	PasswordHashCost     int           `env:"PASSWORD_HASH_COST"     envDefault:"12"` // bcrypt cost of stored password hashes
+
+	// Role-Based Access Control Configuration
+	// Permissions granted to each role or admin role level, e.g. "admin=*;agent=product:write"
+	RBACPolicy string `env:"RBAC_POLICY" envDefault:"admin=product:write,installment:approve,refund:review,reschedule:review,wallet:credit,referral:list,agent:manage,user:list,voucher:write,departure:write,payment:record,booking:manage,invoice:list,muthawif:assign;super_admin=admin:manage;agent=;fin_inst=;customer=payment:record;muthawif="`
+
+	// Initial Super Admin Configuration, used to create the first super admin when none exists
+	BootstrapSuperAdmin struct {
+		FullName string `env:"BOOTSTRAP_SUPER_ADMIN_NAME"     envDefault:"Super Admin"`
+		Email    string `env:"BOOTSTRAP_SUPER_ADMIN_EMAIL"`
+		Password string `env:"BOOTSTRAP_SUPER_ADMIN_PASSWORD"`
+	}
+
+	// Booking Configuration
+	BookingDownPaymentPercent   float64       `env:"BOOKING_DOWN_PAYMENT_PERCENT"    envDefault:"30"`  // Minimum share of the total amount to reach the 'dp' payment status
+	BookingDownPaymentMinAmount float64       `env:"BOOKING_DOWN_PAYMENT_MIN_AMOUNT" envDefault:"0"`   // Minimum absolute amount to reach the 'dp' payment status
+	BookingCodePrefix           string        `env:"BOOKING_CODE_PREFIX"             envDefault:"TRV"` // Prefix of generated booking codes, e.g. TRV-2026-10-7K3M9Q
+	BookingPaymentTimeout       time.Duration `env:"BOOKING_PAYMENT_TIMEOUT"         envDefault:"24h"` // Time a new booking has to receive its first payment
+
+	// Booking Expiry Worker Configuration
+	BookingExpiry struct {
+		Enabled   bool          `env:"BOOKING_EXPIRY_ENABLED"    envDefault:"true"`
+		Interval  time.Duration `env:"BOOKING_EXPIRY_INTERVAL"   envDefault:"1m"`
+		BatchSize int           `env:"BOOKING_EXPIRY_BATCH_SIZE" envDefault:"100"`
+	}
+
+	// Referral Configuration
+	ReferralRewardAmount float64 `env:"REFERRAL_REWARD_AMOUNT" envDefault:"0"` // Wallet credit a referrer earns when a referred user's first booking is paid, 0 disables rewards
+	ReferralCodeLength   int     `env:"REFERRAL_CODE_LENGTH"   envDefault:"8"` // Length of generated referral codes
+
+	// Invoice Configuration
+	InvoiceNumberPrefix  string  `env:"INVOICE_NUMBER_PREFIX"  envDefault:"INV"`    // Prefix of invoice numbers, e.g. INV-202610-00042
+	InvoiceTaxPercent    float64 `env:"INVOICE_TAX_PERCENT"    envDefault:"0"`      // Tax rate included in booking prices
+	InvoiceIssuerName    string  `env:"INVOICE_ISSUER_NAME"    envDefault:"Travel"` // Company name printed on invoices and receipts
+	InvoiceIssuerAddress string  `env:"INVOICE_ISSUER_ADDRESS"`                     // Company address printed below the name, one line per newline
 
 	// Email Service Configuration (Mailgun)
+	MailDriver      string `env:"MAIL_DRIVER"          envDefault:"log"` // Options: "log", "mailgun"
+	MailLogDir      string `env:"MAIL_LOG_DIR"`                          // Directory the log driver also writes emails to, empty to only log them
 	MailgunApiKey   string `env:"MAILGUN_API_KEY"`
 	MailgunDomain   string `env:"MAILGUN_DOMAIN"`
+	MailgunBaseURL  string `env:"MAILGUN_API_BASE_URL" envDefault:"https://api.mailgun.net"` // Use https://api.eu.mailgun.net for EU domains
 	MailSenderEmail string `env:"MAILGUN_SENDER_EMAIL"`
 
+	// Email Verification Configuration
+	EmailVerification struct {
+		URL             string        `env:"EMAIL_VERIFICATION_URL"                envDefault:"http://localhost:5173/verify-email"` // Page the verification link opens, with the token in the "token" query parameter
+		TTL             time.Duration `env:"EMAIL_VERIFICATION_TTL"                envDefault:"24h"`
+		ResendCooldown  time.Duration `env:"EMAIL_VERIFICATION_RESEND_COOLDOWN"    envDefault:"1m"`
+		MaxSendsPerHour int           `env:"EMAIL_VERIFICATION_MAX_SENDS_PER_HOUR" envDefault:"5"`
+	}
+
+	// Password Reset Configuration
+	PasswordReset struct {
+		URL            string        `env:"PASSWORD_RESET_URL"             envDefault:"http://localhost:5173/reset-password"` // Page the reset link opens, with the token in the "token" query parameter
+		TTL            time.Duration `env:"PASSWORD_RESET_TTL"             envDefault:"30m"`
+		ResendCooldown time.Duration `env:"PASSWORD_RESET_RESEND_COOLDOWN" envDefault:"1m"`
+	}
+
 	// OpenTelemetry Tracing Configuration
 	Tracing struct {
 		Enabled  bool   `env:"TRACING_ENABLED"  envDefault:"false"`
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/rbac"
+	"github.com/caarlos0/env/v11"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	var cfg Config
+	err := env.ParseWithOptions(&cfg, env.Options{Environment: map[string]string{}})
+	if err != nil {
+		t.Fatalf("env.Parse() error = %v", err)
+	}
+
+	p, err := rbac.ParsePolicy(cfg.RBACPolicy)
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	tests := []struct {
+		role       string
+		permission rbac.Permission
+		want       bool
+	}{
+		{"admin", rbac.MuthawifAssign, true},
+		{"muthawif", rbac.MuthawifAssign, false},
+		{"agent", rbac.MuthawifAssign, false},
+		{"customer", rbac.MuthawifAssign, false},
+		{"admin", rbac.BookingManage, true},
+		{"customer", rbac.BookingManage, false},
+		{"admin", rbac.InvoiceList, true},
+		{"customer", rbac.InvoiceList, false},
+		{"customer", rbac.PaymentRecord, true},
+		{"fin_inst", rbac.InstallmentApprove, false},
+		{"super_admin", rbac.AdminManage, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+" "+string(tt.permission), func(t *testing.T) {
+			if got := p.Allows([]string{tt.role}, tt.permission); got != tt.want {
+				t.Errorf("Allows(%s, %s) = %v, want %v", tt.role, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
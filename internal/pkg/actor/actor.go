@@ -0,0 +1,87 @@
+package actor
+
+import (
+	"context"
+	"encoding/json"
+
+	"gorm.io/datatypes"
+)
+
+// SystemUID is the identifier recorded for operations that are not triggered by a person,
+// matching the default value of the "created_by" columns in the schema.
+const SystemUID = "SYSTEM"
+
+// Actor represents the identity performing an operation.
+// Only UID and Name are persisted into the audit columns ("created_by", "modified_by", ...).
+// Level is the role level of admins, e.g. "super_admin", and empty for users.
+// SessionID identifies the login session an authenticated actor's access token belongs to.
+type Actor struct {
+	ID        uint   `json:"-"`
+	UID       string `json:"user_uid"`
+	Name      string `json:"user_name"`
+	Role      string `json:"-"`
+	Level     string `json:"-"`
+	SessionID string `json:"-"`
+}
+
+// contextKey is the unexported key type used to store the Actor in a context.
+type contextKey struct{}
+
+// System returns the actor used for background jobs and unauthenticated operations.
+func System() Actor {
+	return Actor{UID: SystemUID, Name: SystemUID}
+}
+
+// NewContext returns a copy of ctx carrying the given actor.
+func NewContext(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, a)
+}
+
+// ValueSetter is implemented by request contexts that carry their own values, such as *fasthttp.RequestCtx.
+type ValueSetter interface {
+	SetUserValue(key any, value any)
+}
+
+// Attach stores the actor in a request context that carries its own values, so FromContext finds it
+// in that context and in every context derived from it for the rest of the request.
+func Attach(rc ValueSetter, a Actor) {
+	rc.SetUserValue(contextKey{}, a)
+}
+
+// FromContext returns the actor stored in ctx, falling back to the SYSTEM actor when none is present.
+func FromContext(ctx context.Context) Actor {
+	if a, ok := ctx.Value(contextKey{}).(Actor); ok {
+		return a
+	}
+	return System()
+}
+
+// IsSystem reports whether the actor is the SYSTEM actor.
+func (a Actor) IsSystem() bool {
+	return a.UID == SystemUID
+}
+
+// Roles returns the roles the actor's permissions are resolved from: its role and, if set, its role level.
+func (a Actor) Roles() []string {
+	if a.Role == "" {
+		return nil
+	}
+	if a.Level == "" {
+		return []string{a.Role}
+	}
+	return []string{a.Role, a.Level}
+}
+
+// JSON encodes the actor into the audit column format: {"user_uid": ..., "user_name": ...}.
+func (a Actor) JSON() datatypes.JSON {
+	b, _ := json.Marshal(a)
+	return b
+}
+
+// Parse decodes an actor from the audit column format. Only UID and Name are restored;
+// values that cannot be decoded yield an actor without identity.
+func Parse(data datatypes.JSON) Actor {
+	var a Actor
+	_ = json.Unmarshal(data, &a)
+	return a
+}
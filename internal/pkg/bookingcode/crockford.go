@@ -0,0 +1,58 @@
+package bookingcode
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+)
+
+// crockfordAlphabet is the Crockford base32 alphabet. It omits I, L, O and U to avoid
+// characters that are easily confused when codes are read aloud or typed by customers.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// crockfordGenerator implements the contract.BookingCodeGenerator interface.
+// It produces codes in the form PREFIX-YYYY-MM-XXXXXX, e.g. "TRV-2026-10-7K3M9Q".
+type crockfordGenerator struct {
+	prefix    string
+	suffixLen int
+}
+
+// NewCrockfordGenerator creates a new generator using the given prefix and random suffix length.
+// The prefix is upper-cased, since codes are looked up in upper case.
+func NewCrockfordGenerator(prefix string, suffixLen int) contract.BookingCodeGenerator {
+	if suffixLen <= 0 {
+		suffixLen = 6
+	}
+	return &crockfordGenerator{prefix: strings.ToUpper(strings.TrimSpace(prefix)), suffixLen: suffixLen}
+}
+
+// Ensures implementation satisfies the contract at compile-time.
+var _ contract.BookingCodeGenerator = (*crockfordGenerator)(nil)
+
+// Generate returns a new booking code for the given time using a cryptographically random suffix.
+func (g *crockfordGenerator) Generate(now time.Time) (string, error) {
+	suffix, err := randomCrockford(g.suffixLen)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate booking code: %w", err)
+	}
+
+	return fmt.Sprintf("%s-%s-%s", g.prefix, now.Format("2006-01"), suffix), nil
+}
+
+// randomCrockford returns a random string of length n drawn from the Crockford base32 alphabet.
+func randomCrockford(n int) (string, error) {
+	base := big.NewInt(int64(len(crockfordAlphabet)))
+	buf := make([]byte, n)
+	for i := range buf {
+		idx, err := rand.Int(rand.Reader, base)
+		if err != nil {
+			return "", err
+		}
+		buf[i] = crockfordAlphabet[idx.Int64()]
+	}
+	return string(buf), nil
+}
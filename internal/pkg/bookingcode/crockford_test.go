@@ -0,0 +1,38 @@
+package bookingcode
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCrockfordGeneratorGenerate(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		prefix string
+		want   string
+	}{
+		{"upper-case prefix", "TRV", `^TRV-2026-10-[0-9A-HJKMNP-TV-Z]{6}$`},
+		{"lower-case prefix", "trv", `^TRV-2026-10-[0-9A-HJKMNP-TV-Z]{6}$`},
+		{"padded prefix", " Umr ", `^UMR-2026-10-[0-9A-HJKMNP-TV-Z]{6}$`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := NewCrockfordGenerator(tt.prefix, 6).Generate(now)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if !regexp.MustCompile(tt.want).MatchString(code) {
+				t.Errorf("Generate() = %q, want a match of %s", code, tt.want)
+			}
+			if code != strings.ToUpper(code) {
+				t.Errorf("Generate() = %q, want an upper-case code it can be looked up by", code)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ const (
 	ForeignKeyViolation = "23503"
 	NotNullViolation    = "23502"
 	CheckViolation      = "23514"
+	ExclusionViolation  = "23P01"
 
 	// Class 08 — Connection Exception
 	ConnectionException = "08000"
@@ -0,0 +1,88 @@
+package gormhelper
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"gorm.io/gorm"
+)
+
+// Audit column names shared by the tables of the schema.
+const (
+	auditCreatedBy  = "created_by"
+	auditModifiedOn = "modified_on"
+	auditModifiedBy = "modified_by"
+)
+
+// AuditPlugin is a GORM plugin filling the audit columns of every model that has them from the actor
+// carried in the statement's context, falling back to the SYSTEM actor for background jobs.
+//
+// On create, created_by is set unless the record already carries a value. On update, including
+// soft deletes, modified_on and modified_by are always set, since records loaded for an update carry
+// the values of the previous one. Updates skipping hooks, such as UpdateColumn, are left untouched.
+type AuditPlugin struct{}
+
+// Ensures implementation satisfies the plugin interface at compile-time.
+var _ gorm.Plugin = AuditPlugin{}
+
+// Name returns the name of the plugin.
+func (AuditPlugin) Name() string {
+	return "audit"
+}
+
+// Initialize registers the audit callbacks on the database.
+func (AuditPlugin) Initialize(db *gorm.DB) error {
+	err := db.Callback().Create().Before("gorm:create").Register("audit:created_by", setCreatedBy)
+	if err != nil {
+		return err
+	}
+
+	return db.Callback().Update().After("gorm:before_update").Before("gorm:update").Register("audit:modified", setModified)
+}
+
+// setCreatedBy fills created_by on every created record that has none.
+func setCreatedBy(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+
+	field := db.Statement.Schema.LookUpField(auditCreatedBy)
+	if field == nil {
+		return
+	}
+
+	by := actor.FromContext(db.Statement.Context).JSON()
+	fill := func(rv reflect.Value) {
+		rv = reflect.Indirect(rv)
+		if rv.Kind() != reflect.Struct {
+			return
+		}
+		if _, zero := field.ValueOf(db.Statement.Context, rv); zero {
+			db.AddError(field.Set(db.Statement.Context, rv, by))
+		}
+	}
+
+	switch db.Statement.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < db.Statement.ReflectValue.Len(); i++ {
+			fill(db.Statement.ReflectValue.Index(i))
+		}
+	case reflect.Struct:
+		fill(db.Statement.ReflectValue)
+	}
+}
+
+// setModified sets modified_on and modified_by on the updated records.
+func setModified(db *gorm.DB) {
+	if db.Statement.Schema == nil || db.Statement.SkipHooks {
+		return
+	}
+
+	if db.Statement.Schema.LookUpField(auditModifiedOn) != nil {
+		db.Statement.SetColumn(auditModifiedOn, time.Now(), true)
+	}
+	if db.Statement.Schema.LookUpField(auditModifiedBy) != nil {
+		db.Statement.SetColumn(auditModifiedBy, actor.FromContext(db.Statement.Context).JSON(), true)
+	}
+}
@@ -0,0 +1,138 @@
+package gormhelper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"gorm.io/datatypes"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type auditedRecord struct {
+	ID         uint `gorm:"primaryKey"`
+	Name       string
+	CreatedBy  datatypes.JSON
+	ModifiedOn *time.Time
+	ModifiedBy datatypes.JSON
+}
+
+type plainRecord struct {
+	ID   uint `gorm:"primaryKey"`
+	Name string
+}
+
+// newDryRunDB opens a database that builds statements without executing them, with the audit plugin registered.
+func newDryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost dbname=test"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	err = db.Use(AuditPlugin{})
+	if err != nil {
+		t.Fatalf("db.Use() error = %v", err)
+	}
+
+	return db
+}
+
+func TestAuditPluginCreate(t *testing.T) {
+	db := newDryRunDB(t)
+	principal := actor.Actor{ID: 7, UID: "u-7", Name: "Siti", Role: "customer"}
+	ctx := actor.NewContext(context.Background(), principal)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		by   datatypes.JSON
+		want datatypes.JSON
+	}{
+		{"actor from context", ctx, nil, principal.JSON()},
+		{"system without actor", context.Background(), nil, actor.System().JSON()},
+		{"explicit value kept", ctx, datatypes.JSON(`{"user_uid":"other"}`), datatypes.JSON(`{"user_uid":"other"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := auditedRecord{Name: "a", CreatedBy: tt.by}
+			err := db.WithContext(tt.ctx).Create(&rec).Error
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			if string(rec.CreatedBy) != string(tt.want) {
+				t.Errorf("created_by = %s, want %s", rec.CreatedBy, tt.want)
+			}
+		})
+	}
+
+	t.Run("every record of a batch", func(t *testing.T) {
+		recs := []auditedRecord{{Name: "a"}, {Name: "b", CreatedBy: datatypes.JSON(`{"user_uid":"other"}`)}}
+		err := db.WithContext(ctx).Create(&recs).Error
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		if string(recs[0].CreatedBy) != string(principal.JSON()) || string(recs[1].CreatedBy) != `{"user_uid":"other"}` {
+			t.Errorf("created_by = %s, %s, want %s and the explicit value", recs[0].CreatedBy, recs[1].CreatedBy, principal.JSON())
+		}
+	})
+
+	t.Run("models without audit columns", func(t *testing.T) {
+		err := db.WithContext(ctx).Create(&plainRecord{Name: "a"}).Error
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	})
+}
+
+func TestAuditPluginUpdate(t *testing.T) {
+	db := newDryRunDB(t)
+	principal := actor.Actor{ID: 1, UID: "a-1", Name: "Admin", Role: "admin"}
+	ctx := actor.NewContext(context.Background(), principal)
+	previous := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		update func(tx *gorm.DB, rec *auditedRecord) *gorm.DB
+		want   bool
+	}{
+		{"save", func(tx *gorm.DB, rec *auditedRecord) *gorm.DB { return tx.Save(rec) }, true},
+		{"updates", func(tx *gorm.DB, rec *auditedRecord) *gorm.DB {
+			return tx.Model(rec).Updates(map[string]any{"name": "b"})
+		}, true},
+		{"update column", func(tx *gorm.DB, rec *auditedRecord) *gorm.DB { return tx.Model(rec).UpdateColumn("name", "b") }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Records loaded for an update carry the audit values of the previous one
+			rec := auditedRecord{ID: 1, Name: "a", ModifiedOn: &previous, ModifiedBy: datatypes.JSON(`{"user_uid":"previous"}`)}
+			stmt := tt.update(db.WithContext(ctx), &rec).Statement
+
+			sql := stmt.SQL.String()
+			if !strings.HasPrefix(sql, "UPDATE") {
+				t.Fatalf("statement = %s, want an UPDATE", sql)
+			}
+
+			hasBy := slices.ContainsFunc(stmt.Vars, func(v any) bool {
+				return fmt.Sprint(v) == string(principal.JSON())
+			})
+			if hasBy != tt.want || strings.Contains(sql, `"modified_on"`) != tt.want {
+				t.Errorf("statement = %s with %v, want modified_on and modified_by of the actor set: %v", sql, stmt.Vars, tt.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 func MapErrorToHTTPStatus(code apperror.Code) int {
 	switch code {
 	case
+		apperror.NotFound,
 		apperror.UserNotFound,
 		apperror.ProductNotFound,
 		apperror.BookingNotFound:
@@ -17,23 +18,42 @@ func MapErrorToHTTPStatus(code apperror.Code) int {
 
 	case
 		apperror.EmailExists,
+		apperror.PhoneExists,
+		apperror.ReferralExists,
 		apperror.DuplicateEntry,
-		apperror.BookingAlreadyConfirmed:
+		apperror.StateConflict,
+		apperror.BookingAlreadyConfirmed,
+		apperror.BookingNotCancellable,
+		apperror.BookingBatchSoldOut:
 		return http.StatusConflict
 
 	case
 		apperror.Validation,
-		apperror.BadRequest:
+		apperror.BadRequest,
+		apperror.VoucherExpired:
 		return http.StatusBadRequest
 
 	case
-		apperror.Unauthenticated:
+		apperror.InsufficientFunds:
+		return http.StatusUnprocessableEntity
+
+	case
+		apperror.Unauthenticated,
+		apperror.TokenExpired:
 		return http.StatusUnauthorized
 
 	case
 		apperror.Unauthorized:
 		return http.StatusForbidden
 
+	case
+		apperror.RateLimitExceeded:
+		return http.StatusTooManyRequests
+
+	case
+		apperror.ServiceUnavailable:
+		return http.StatusServiceUnavailable
+
 	default:
 		return http.StatusInternalServerError
 	}
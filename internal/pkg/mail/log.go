@@ -0,0 +1,64 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+)
+
+// logSender implements the contract.MailSender interface without delivering anything,
+// for development and tests: messages are logged and, when a directory is set, written to it as files.
+type logSender struct {
+	logger *slog.Logger
+	dir    string
+}
+
+// NewLogSender creates a new sender logging every message. When dir is not empty, each message
+// is also written there as a text file named after the time it was sent and its recipient.
+func NewLogSender(logger *slog.Logger, dir string) contract.MailSender {
+	return &logSender{logger: logger, dir: dir}
+}
+
+// Ensures implementation satisfies the contract at compile-time.
+var _ contract.MailSender = (*logSender)(nil)
+
+// Send logs the message and writes it to the directory, if any.
+func (s *logSender) Send(ctx context.Context, msg contract.MailMessage) error {
+	s.logger.InfoContext(ctx, "email sent to log sink", "to", msg.To, "subject", msg.Subject, "text", msg.Text)
+
+	if s.dir == "" {
+		return nil
+	}
+
+	err := os.MkdirAll(s.dir, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	name := fmt.Sprintf("%s_%s.txt", time.Now().UTC().Format("20060102T150405.000000000"), sanitize(msg.To))
+	content := fmt.Sprintf("To: %s\nSubject: %s\n\n%s\n", msg.To, msg.Subject, msg.Text)
+
+	err = os.WriteFile(filepath.Join(s.dir, name), []byte(content), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write email to file: %w", err)
+	}
+
+	return nil
+}
+
+// sanitize replaces the characters of an address that are unsafe in file names.
+func sanitize(address string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_', r == '@':
+			return r
+		}
+		return '_'
+	}, address)
+}
@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer trace.Tracer = otel.Tracer("mail")
+
+// DefaultMailgunBaseURL is the base URL of Mailgun's US region API.
+const DefaultMailgunBaseURL = "https://api.mailgun.net"
+
+// MailgunOption holds the settings of the Mailgun sender.
+type MailgunOption struct {
+	APIKey  string
+	Domain  string
+	From    string        // Sender address, e.g. "Travel <no-reply@example.com>"
+	BaseURL string        // API base URL; defaults to DefaultMailgunBaseURL, use https://api.eu.mailgun.net for EU domains
+	Timeout time.Duration // Timeout of a send request; defaults to 10 seconds
+}
+
+// mailgunSender implements the contract.MailSender interface with Mailgun's messages API.
+type mailgunSender struct {
+	opt    MailgunOption
+	client *http.Client
+}
+
+// NewMailgunSender creates a new sender delivering emails through Mailgun.
+func NewMailgunSender(opt MailgunOption) contract.MailSender {
+	if opt.BaseURL == "" {
+		opt.BaseURL = DefaultMailgunBaseURL
+	}
+	if opt.Timeout <= 0 {
+		opt.Timeout = 10 * time.Second
+	}
+
+	return &mailgunSender{opt: opt, client: &http.Client{Timeout: opt.Timeout}}
+}
+
+// Ensures implementation satisfies the contract at compile-time.
+var _ contract.MailSender = (*mailgunSender)(nil)
+
+// Send posts the message to Mailgun. Any response other than 200 OK is returned as an error.
+func (s *mailgunSender) Send(ctx context.Context, msg contract.MailMessage) error {
+	ctx, span := tracer.Start(ctx, "Mailgun.Send")
+	defer span.End()
+
+	form := url.Values{}
+	form.Set("from", s.opt.From)
+	form.Set("to", msg.To)
+	form.Set("subject", msg.Subject)
+	form.Set("text", msg.Text)
+	if msg.HTML != "" {
+		form.Set("html", msg.HTML)
+	}
+
+	endpoint := fmt.Sprintf("%s/v3/%s/messages", strings.TrimSuffix(s.opt.BaseURL, "/"), url.PathEscape(s.opt.Domain))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to build mailgun request: %w", err)
+	}
+	req.SetBasicAuth("api", s.opt.APIKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send email through mailgun: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("mailgun rejected the email with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
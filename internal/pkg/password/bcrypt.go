@@ -0,0 +1,49 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptHasher implements the contract.PasswordHasher interface using bcrypt.
+// bcrypt only considers the first 72 bytes of a password, so callers should cap password length.
+type bcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a new hasher using the given bcrypt cost.
+// Costs outside the range supported by bcrypt fall back to bcrypt.DefaultCost.
+func NewBcryptHasher(cost int) contract.PasswordHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &bcryptHasher{cost: cost}
+}
+
+// Ensures implementation satisfies the contract at compile-time.
+var _ contract.PasswordHasher = (*bcryptHasher)(nil)
+
+// Hash returns the bcrypt hash of the password.
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// Verify reports whether the password matches the bcrypt hash.
+// A mismatch is not an error; only malformed hashes are.
+func (h *bcryptHasher) Verify(hash, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to verify password: %w", err)
+	}
+	return true, nil
+}
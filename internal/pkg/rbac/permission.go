@@ -0,0 +1,46 @@
+package rbac
+
+// Permission names an action that can be granted to roles, in the form "<resource>:<action>".
+type Permission string
+
+// Wildcard grants every permission to a role.
+const Wildcard Permission = "*"
+
+// Permissions checked by the API routes.
+const (
+	ProductWrite       Permission = "product:write"       // Create, update and delete products
+	AdminManage        Permission = "admin:manage"        // Create, disable and re-role admin accounts and register financing institutions
+	InstallmentApprove Permission = "installment:approve" // Approve or reject installment plans and list overdue dues
+	RefundReview       Permission = "refund:review"       // Approve, reject, process and complete refunds and list all refunds
+	RescheduleReview   Permission = "reschedule:review"   // Approve, reject and process reschedules and list all reschedules
+	WalletCredit       Permission = "wallet:credit"       // Credit top-ups and cashback to user wallets
+	ReferralList       Permission = "referral:list"       // List the referrals of all users
+	AgentManage        Permission = "agent:manage"        // Create, list and update agents and manage commission rates
+	UserList           Permission = "user:list"           // List all users
+	VoucherWrite       Permission = "voucher:write"       // Create, list, update and delete vouchers and list their redemptions
+	DepartureWrite     Permission = "departure:write"     // Create, update and delete departure batches
+	PaymentRecord      Permission = "payment:record"      // Record payments on bookings, limited to own bookings paid from the wallet for customers
+	BookingManage      Permission = "booking:manage"      // Delete bookings and confirm, start and complete them
+	InvoiceList        Permission = "invoice:list"        // List invoices and view them by ID
+	MuthawifAssign     Permission = "muthawif:assign"     // Assign muthawifs to departure batches and unassign them
+)
+
+// known lists every permission a policy may grant, so typos in the configured policy fail at startup.
+var known = map[Permission]bool{
+	Wildcard:           true,
+	ProductWrite:       true,
+	AdminManage:        true,
+	InstallmentApprove: true,
+	RefundReview:       true,
+	RescheduleReview:   true,
+	WalletCredit:       true,
+	ReferralList:       true,
+	AgentManage:        true,
+	UserList:           true,
+	VoucherWrite:       true,
+	DepartureWrite:     true,
+	PaymentRecord:      true,
+	BookingManage:      true,
+	InvoiceList:        true,
+	MuthawifAssign:     true,
+}
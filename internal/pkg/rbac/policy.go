@@ -0,0 +1,111 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+// Policy maps roles to the permissions granted to them. Roles without grants are allowed nothing.
+// It holds no HTTP concerns, so authorization decisions can be checked on their own.
+type Policy struct {
+	grants map[string]map[Permission]bool
+}
+
+// NewPolicy creates a policy granting each role the given permissions.
+func NewPolicy(grants map[string][]Permission) *Policy {
+	p := &Policy{grants: make(map[string]map[Permission]bool, len(grants))}
+	for role, permissions := range grants {
+		set := make(map[Permission]bool, len(permissions))
+		for _, permission := range permissions {
+			set[permission] = true
+		}
+		p.grants[role] = set
+	}
+	return p
+}
+
+// ParsePolicy parses a policy from its configuration format: entries of "role=permission,permission"
+// separated by semicolons, e.g. "admin=*;agent=product:write". Whitespace around names is ignored.
+// A role may be listed with no permissions, and unknown permissions are rejected.
+func ParsePolicy(spec string) (*Policy, error) {
+	grants := make(map[string][]Permission)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		role, list, found := strings.Cut(entry, "=")
+		role = strings.TrimSpace(role)
+		if !found || role == "" {
+			return nil, fmt.Errorf("invalid policy entry %q: expected role=permission,...", entry)
+		}
+		if _, dup := grants[role]; dup {
+			return nil, fmt.Errorf("invalid policy entry %q: role %q is listed more than once", entry, role)
+		}
+
+		permissions := []Permission{}
+		for _, name := range strings.Split(list, ",") {
+			permission := Permission(strings.TrimSpace(name))
+			if permission == "" {
+				continue
+			}
+			if !known[permission] {
+				return nil, fmt.Errorf("invalid policy entry %q: unknown permission %q", entry, permission)
+			}
+			permissions = append(permissions, permission)
+		}
+		grants[role] = permissions
+	}
+
+	return NewPolicy(grants), nil
+}
+
+// Allows reports whether the roles together are granted every one of the permissions.
+func (p *Policy) Allows(roles []string, permissions ...Permission) bool {
+	return len(p.Missing(roles, permissions...)) == 0
+}
+
+// Missing returns the permissions none of the roles is granted, in the order they were given.
+func (p *Policy) Missing(roles []string, permissions ...Permission) []Permission {
+	var missing []Permission
+	for _, permission := range permissions {
+		if !p.granted(roles, permission) {
+			missing = append(missing, permission)
+		}
+	}
+	return missing
+}
+
+// Authorize returns an Unauthorized error listing the missing permissions when the roles
+// together are not granted every one of the permissions, and nil otherwise.
+func (p *Policy) Authorize(roles []string, permissions ...Permission) error {
+	missing := p.Missing(roles, permissions...)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(missing))
+	for i, permission := range missing {
+		names[i] = string(permission)
+	}
+
+	return apperror.New(
+		apperror.Unauthorized,
+		"you do not have permission to perform this action",
+		nil,
+		map[string]any{"missing_permissions": names},
+	)
+}
+
+// granted reports whether any of the roles is granted the permission, directly or through the wildcard.
+func (p *Policy) granted(roles []string, permission Permission) bool {
+	for _, role := range roles {
+		if p.grants[role][Wildcard] || p.grants[role][permission] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package rbac
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aburizalpurnama/travel/internal/pkg/apperror"
+)
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+		allowed map[string][]Permission
+		denied  map[string][]Permission
+	}{
+		{
+			name:   "empty",
+			spec:   "",
+			denied: map[string][]Permission{"admin": {ProductWrite}},
+		},
+		{
+			name:    "grants per role",
+			spec:    "admin=product:write,refund:review;agent=product:write",
+			allowed: map[string][]Permission{"admin": {ProductWrite, RefundReview}, "agent": {ProductWrite}},
+			denied:  map[string][]Permission{"agent": {RefundReview}, "customer": {ProductWrite}},
+		},
+		{
+			name:    "whitespace and empty entries",
+			spec:    " admin = product:write , refund:review ;; agent= ;",
+			allowed: map[string][]Permission{"admin": {ProductWrite, RefundReview}},
+			denied:  map[string][]Permission{"agent": {ProductWrite}},
+		},
+		{
+			name:    "wildcard",
+			spec:    "super_admin=*",
+			allowed: map[string][]Permission{"super_admin": {ProductWrite, AdminManage, UserList}},
+		},
+		{name: "missing separator", spec: "admin", wantErr: true},
+		{name: "missing role", spec: "=product:write", wantErr: true},
+		{name: "duplicate role", spec: "admin=product:write;admin=refund:review", wantErr: true},
+		{name: "unknown permission", spec: "admin=product:writ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParsePolicy(tt.spec)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParsePolicy(%q) error = nil, want an error", tt.spec)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePolicy(%q) error = %v", tt.spec, err)
+			}
+
+			for role, permissions := range tt.allowed {
+				for _, permission := range permissions {
+					if !p.Allows([]string{role}, permission) {
+						t.Errorf("Allows(%s, %s) = false, want true", role, permission)
+					}
+				}
+			}
+			for role, permissions := range tt.denied {
+				for _, permission := range permissions {
+					if p.Allows([]string{role}, permission) {
+						t.Errorf("Allows(%s, %s) = true, want false", role, permission)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyAllows(t *testing.T) {
+	p := NewPolicy(map[string][]Permission{
+		"admin":       {ProductWrite, RefundReview},
+		"super_admin": {AdminManage},
+		"root":        {Wildcard},
+	})
+
+	tests := []struct {
+		name        string
+		roles       []string
+		permissions []Permission
+		want        bool
+	}{
+		{"granted", []string{"admin"}, []Permission{ProductWrite}, true},
+		{"all granted", []string{"admin"}, []Permission{ProductWrite, RefundReview}, true},
+		{"one missing", []string{"admin"}, []Permission{ProductWrite, AdminManage}, false},
+		{"granted through role level", []string{"admin", "super_admin"}, []Permission{ProductWrite, AdminManage}, true},
+		{"wildcard", []string{"root"}, []Permission{UserList, VoucherWrite}, true},
+		{"unknown role", []string{"customer"}, []Permission{ProductWrite}, false},
+		{"no roles", nil, []Permission{ProductWrite}, false},
+		{"no permissions", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Allows(tt.roles, tt.permissions...); got != tt.want {
+				t.Errorf("Allows(%v, %v) = %v, want %v", tt.roles, tt.permissions, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyAuthorize(t *testing.T) {
+	p := NewPolicy(map[string][]Permission{"admin": {ProductWrite}})
+
+	if err := p.Authorize([]string{"admin"}, ProductWrite); err != nil {
+		t.Fatalf("Authorize() error = %v, want nil", err)
+	}
+
+	err := p.Authorize([]string{"admin"}, ProductWrite, RefundReview, UserList)
+
+	var appErr *apperror.AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("Authorize() error = %v, want an AppError", err)
+	}
+	if appErr.Code != apperror.Unauthorized {
+		t.Errorf("Authorize() code = %s, want %s", appErr.Code, apperror.Unauthorized)
+	}
+
+	missing, _ := appErr.Details["missing_permissions"].([]string)
+	if want := []string{"refund:review", "user:list"}; !slices.Equal(missing, want) {
+		t.Errorf("Authorize() missing_permissions = %v, want %v", missing, want)
+	}
+}
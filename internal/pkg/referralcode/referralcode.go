@@ -0,0 +1,44 @@
+package referralcode
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+)
+
+// crockfordAlphabet is the Crockford base32 alphabet. It omits I, L, O and U so codes
+// shared by word of mouth are not misread.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// crockfordGenerator implements the contract.ReferralCodeGenerator interface.
+// It produces fixed-length codes such as "7K3M9QXA".
+type crockfordGenerator struct {
+	length int
+}
+
+// NewCrockfordGenerator creates a new generator producing codes of the given length.
+func NewCrockfordGenerator(length int) contract.ReferralCodeGenerator {
+	if length <= 0 {
+		length = 8
+	}
+	return &crockfordGenerator{length: length}
+}
+
+// Ensures implementation satisfies the contract at compile-time.
+var _ contract.ReferralCodeGenerator = (*crockfordGenerator)(nil)
+
+// Generate returns a new cryptographically random referral code.
+func (g *crockfordGenerator) Generate() (string, error) {
+	base := big.NewInt(int64(len(crockfordAlphabet)))
+	buf := make([]byte, g.length)
+	for i := range buf {
+		idx, err := rand.Int(rand.Reader, base)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate referral code: %w", err)
+		}
+		buf[i] = crockfordAlphabet[idx.Int64()]
+	}
+	return string(buf), nil
+}
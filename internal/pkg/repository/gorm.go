@@ -4,12 +4,12 @@ import (
 	"context"
 	"time"
 
-	"github.com/aburizalpurnama/travel/internal/app/model"
 	"github.com/aburizalpurnama/travel/internal/pkg/gormhelper"
 	"github.com/aburizalpurnama/travel/internal/pkg/paginator"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var gormTracer trace.Tracer = otel.Tracer("repository.gorm")
@@ -49,7 +49,7 @@ func (r *GORM[M, F]) FindAll(ctx context.Context, page *int, size *int, filter *
 }
 
 // Count retrieves the total number of records matching the filter criteria.
-func (r *GORM[M, F]) Count(ctx context.Context, filter *model.ProductFilter) (count int64, err error) {
+func (r *GORM[M, F]) Count(ctx context.Context, filter *F) (count int64, err error) {
 	ctx, span := gormTracer.Start(ctx, "GORM.Count")
 	defer span.End()
 
@@ -80,6 +80,20 @@ func (r *GORM[M, F]) FindByID(ctx context.Context, id uint) (*M, error) {
 	return &data, err
 }
 
+// FindByIDForUpdate retrieves a single record by its ID and locks the row (SELECT ... FOR UPDATE)
+// until the surrounding transaction ends. It must be called within a transaction.
+func (r *GORM[M, F]) FindByIDForUpdate(ctx context.Context, id uint) (*M, error) {
+	ctx, span := gormTracer.Start(ctx, "GORM.FindByIDForUpdate")
+	defer span.End()
+
+	var data M
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_on IS NULL").
+		First(&data, id).Error
+	return &data, err
+}
+
 // Save persists a new record to the database.
 func (r *GORM[M, F]) Save(ctx context.Context, data *M) (*M, error) {
 	ctx, span := gormTracer.Start(ctx, "GORM.Save")
@@ -0,0 +1,98 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/aburizalpurnama/travel/internal/pkg/actor"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrExpired is returned by Parse for well-formed tokens whose expiry has passed.
+	ErrExpired = errors.New("token expired")
+
+	// ErrInvalid is returned by Parse for malformed tokens or tokens with a bad signature.
+	ErrInvalid = errors.New("token invalid")
+)
+
+// claims are the JWT claims of an access token. The subject holds the principal's ID
+// and the session ID ties the token to the login session it was issued for.
+// The level is only set for admins.
+type claims struct {
+	jwt.RegisteredClaims
+	UID       string `json:"uid"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Level     string `json:"lvl,omitempty"`
+	SessionID string `json:"sid"`
+}
+
+// jwtManager implements the contract.AccessTokenManager interface with HMAC-SHA256 signed JWTs.
+type jwtManager struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewJWTManager creates a new access token manager signing tokens with the given secret,
+// each valid for ttl after it is issued.
+func NewJWTManager(secret string, ttl time.Duration) contract.AccessTokenManager {
+	return &jwtManager{secret: []byte(secret), ttl: ttl}
+}
+
+// Ensures implementation satisfies the contract at compile-time.
+var _ contract.AccessTokenManager = (*jwtManager)(nil)
+
+// Issue returns a signed access token for the principal and the time it expires at.
+func (m *jwtManager) Issue(principal actor.Actor) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.ttl)
+
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatUint(uint64(principal.ID), 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		UID:       principal.UID,
+		Name:      principal.Name,
+		Role:      principal.Role,
+		Level:     principal.Level,
+		SessionID: principal.SessionID,
+	})
+
+	signed, err := t.SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return signed, expiresAt, nil
+}
+
+// Parse validates the signature and expiry of an access token and returns its principal.
+// It fails with ErrExpired for expired tokens and ErrInvalid for anything else that is wrong with the token.
+func (m *jwtManager) Parse(token string) (actor.Actor, error) {
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(t *jwt.Token) (any, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return actor.Actor{}, ErrExpired
+		}
+		return actor.Actor{}, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	id, err := strconv.ParseUint(c.Subject, 10, 64)
+	if err != nil || c.UID == "" || c.Role == "" || c.SessionID == "" {
+		return actor.Actor{}, ErrInvalid
+	}
+
+	return actor.Actor{ID: uint(id), UID: c.UID, Name: c.Name, Role: c.Role, Level: c.Level, SessionID: c.SessionID}, nil
+}
@@ -0,0 +1,31 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// opaqueBytes is the amount of randomness in an opaque token.
+const opaqueBytes = 32
+
+// NewOpaque returns a new random opaque token, such as a refresh or password reset token,
+// and the hash to store for it. Only the hash is stored, so a leaked table cannot be used to log in.
+func NewOpaque() (string, string, error) {
+	buf := make([]byte, opaqueBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+	return raw, HashOpaque(raw), nil
+}
+
+// HashOpaque returns the hex-encoded SHA-256 hash under which an opaque token is stored.
+// Opaque tokens are long random values, so a fast unsalted hash is enough to make a leaked table useless.
+func HashOpaque(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,69 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aburizalpurnama/travel/internal/app/contract"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// signedTokenManager implements the contract.SignedTokenManager interface with HMAC-SHA256 signed JWTs
+// whose audience holds the purpose. Access tokens have no audience, so neither kind is accepted as the other.
+type signedTokenManager struct {
+	secret []byte
+}
+
+// NewSignedTokenManager creates a new signed token manager signing tokens with the given secret.
+func NewSignedTokenManager(secret string) contract.SignedTokenManager {
+	return &signedTokenManager{secret: []byte(secret)}
+}
+
+// Ensures implementation satisfies the contract at compile-time.
+var _ contract.SignedTokenManager = (*signedTokenManager)(nil)
+
+// Sign returns a signed token carrying the subject for the purpose and the time it expires at.
+func (m *signedTokenManager) Sign(purpose, subject string, ttl time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   subject,
+		Audience:  jwt.ClaimStrings{purpose},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	})
+
+	signed, err := t.SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign %s token: %w", purpose, err)
+	}
+
+	return signed, expiresAt, nil
+}
+
+// Verify validates the signature, purpose and expiry of a token and returns its subject.
+// It fails with ErrExpired for expired tokens and ErrInvalid for anything else that is wrong with the token.
+func (m *signedTokenManager) Verify(purpose, token string) (string, error) {
+	var c jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &c, func(t *jwt.Token) (any, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithAudience(purpose),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return "", ErrExpired
+		}
+		return "", fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	if c.Subject == "" {
+		return "", ErrInvalid
+	}
+
+	return c.Subject, nil
+}